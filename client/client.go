@@ -0,0 +1,173 @@
+// Package client connects to haaukins store, it signs tokens, sets up TLS,
+// retries idempotent calls and reports failures as typed errors.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// AuthKeyClaim is the claim of the token which carries auth key of the store
+	AuthKeyClaim = "au"
+
+	DefaultHost    = "localhost:50051"
+	DefaultTimeout = 10 * time.Second
+)
+
+// Config is the configuration of a client, it could be read from YAML
+type Config struct {
+	Host      string `yaml:"host"`
+	AuthKey   string `yaml:"auth-key"`
+	SigninKey string `yaml:"signin-key"`
+	// Token is used instead of signing one with the keys, e.g. a token issued for JWKS
+	Token string `yaml:"token"`
+	// Timeout limits each call, including its retries, when the context has no deadline
+	Timeout time.Duration `yaml:"timeout"`
+	TLS     struct {
+		Enabled    bool   `yaml:"enabled"`
+		CertFile   string `yaml:"certfile"`
+		CertKey    string `yaml:"certkey"`
+		CAFile     string `yaml:"cafile"`
+		ServerName string `yaml:"server-name"`
+	} `yaml:"tls"`
+	Retry RetryPolicy `yaml:"retry"`
+}
+
+// Client is a store client, every RPC of the store could be called on it
+type Client struct {
+	pb.StoreClient
+	conn *grpc.ClientConn
+}
+
+// New connects to the store given in configuration,
+// extra options are appended to the ones derived from configuration
+func New(conf Config, opts ...grpc.DialOption) (*Client, error) {
+	dialOpts, err := DialOptions(conf)
+	if err != nil {
+		return nil, err
+	}
+	host := conf.Host
+	if host == "" {
+		host = DefaultHost
+	}
+	conn, err := grpc.Dial(host, append(dialOpts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{StoreClient: pb.NewStoreClient(conn), conn: conn}, nil
+}
+
+// Close closes the connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// DialOptions returns credentials, timeout, retry and error handling of configuration as dial options,
+// so they could be used on connections which are not made by New
+func DialOptions(conf Config) ([]grpc.DialOption, error) {
+	token := conf.Token
+	if token == "" {
+		if conf.AuthKey == "" || conf.SigninKey == "" {
+			return nil, errors.New("either token or both auth key and signin key should be configured")
+		}
+		var err error
+		if token, err = NewToken(conf.AuthKey, conf.SigninKey); err != nil {
+			return nil, fmt.Errorf("unable to sign token: %v", err)
+		}
+	}
+
+	timeout := conf.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	opts := []grpc.DialOption{
+		grpc.WithPerRPCCredentials(Creds{Token: token, Insecure: !conf.TLS.Enabled}),
+		grpc.WithChainUnaryInterceptor(errorInterceptor, timeoutInterceptor(timeout), conf.Retry.withDefaults().interceptor),
+	}
+	if !conf.TLS.Enabled {
+		return append(opts, grpc.WithInsecure()), nil
+	}
+
+	tlsConf, err := TLSConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+	return append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConf))), nil
+}
+
+// NewToken signs a token which is accepted by a store configured with given keys
+func NewToken(authKey, signinKey string) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{AuthKeyClaim: authKey})
+	return token.SignedString([]byte(signinKey))
+}
+
+// Creds sends the token with every call
+type Creds struct {
+	Token    string
+	Insecure bool
+}
+
+func (c Creds) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"token": c.Token}, nil
+}
+
+func (c Creds) RequireTransportSecurity() bool {
+	return !c.Insecure
+}
+
+// TLSConfig returns TLS configuration of the client, system roots are used unless CA is given
+// and client certificate is optional. Server name defaults to the host without port.
+func TLSConfig(conf Config) (*tls.Config, error) {
+	serverName := conf.TLS.ServerName
+	if serverName == "" {
+		serverName = conf.Host
+		if host, _, err := net.SplitHostPort(conf.Host); err == nil {
+			serverName = host
+		}
+	}
+	tlsConf := &tls.Config{ServerName: serverName}
+
+	if conf.TLS.CertFile != "" || conf.TLS.CertKey != "" {
+		certificate, err := tls.LoadX509KeyPair(conf.TLS.CertFile, conf.TLS.CertKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not load client key pair: %v", err)
+		}
+		tlsConf.Certificates = []tls.Certificate{certificate}
+	}
+	if conf.TLS.CAFile != "" {
+		ca, err := ioutil.ReadFile(conf.TLS.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read ca certificate: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates found in %s", conf.TLS.CAFile)
+		}
+		tlsConf.RootCAs = pool
+	}
+	return tlsConf, nil
+}
+
+// timeoutInterceptor sets deadline of calls which have none
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,187 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// flakyStore fails the first failures calls of every method with code
+type flakyStore struct {
+	pb.UnimplementedStoreServer
+	failures int
+	code     codes.Code
+	calls    map[string]int
+	tokens   []string
+}
+
+func (s *flakyStore) call(ctx context.Context, method string) error {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		s.tokens = append(s.tokens, md.Get("token")...)
+	}
+	s.calls[method]++
+	if s.calls[method] > s.failures {
+		return nil
+	}
+	if s.code == codes.ResourceExhausted {
+		grpc.SetHeader(ctx, metadata.Pairs(retryAfterKey, "1"))
+	}
+	return status.Error(s.code, "failed")
+}
+
+func (s *flakyStore) GetEvents(ctx context.Context, in *pb.GetEventRequest) (*pb.GetEventResponse, error) {
+	if err := s.call(ctx, "GetEvents"); err != nil {
+		return nil, err
+	}
+	return &pb.GetEventResponse{Events: []*pb.GetEventResponse_Events{{Tag: "test"}}}, nil
+}
+
+func (s *flakyStore) AddEvent(ctx context.Context, in *pb.AddEventRequest) (*pb.InsertResponse, error) {
+	if err := s.call(ctx, "AddEvent"); err != nil {
+		return nil, err
+	}
+	return &pb.InsertResponse{ErrorMessage: "event exists"}, nil
+}
+
+func startStore(t *testing.T, s *flakyStore) (string, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	pb.RegisterStoreServer(srv, s)
+	go srv.Serve(l)
+	return l.Addr().String(), srv.Stop
+}
+
+func TestClient(t *testing.T) {
+	tt := []struct {
+		name     string
+		failures int
+		code     codes.Code
+		add      bool
+		calls    int
+		err      error
+	}{
+		{name: "Succeeds after retries", failures: 2, code: codes.Unavailable, calls: 3},
+		{name: "Fails after max attempts", failures: 5, code: codes.Unavailable, calls: 3, err: UnavailableErr},
+		{name: "Not retryable code", failures: 1, code: codes.NotFound, calls: 1, err: NotFoundErr},
+		{name: "Not idempotent call", failures: 1, code: codes.Unavailable, add: true, calls: 1, err: UnavailableErr},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &flakyStore{failures: tc.failures, code: tc.code, calls: map[string]int{}}
+			addr, stop := startStore(t, s)
+			defer stop()
+
+			conf := Config{Host: addr, AuthKey: "authkey", SigninKey: "signkey"}
+			conf.Retry.InitialBackoff = time.Millisecond
+			c, err := New(conf)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			defer c.Close()
+
+			method := "GetEvents"
+			if tc.add {
+				method = "AddEvent"
+				var resp *pb.InsertResponse
+				resp, err = c.AddEvent(context.Background(), &pb.AddEventRequest{Tag: "test"})
+				if err == nil {
+					err = CheckResponse(resp)
+				}
+			} else {
+				_, err = c.GetEvents(context.Background(), &pb.GetEventRequest{})
+			}
+
+			if !errors.Is(err, tc.err) || (tc.err == nil) != (err == nil) {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if err != nil && status.Code(err) != tc.code {
+				t.Errorf("expected code %s, got %s", tc.code, status.Code(err))
+			}
+			if s.calls[method] != tc.calls {
+				t.Errorf("expected %d calls, got %d", tc.calls, s.calls[method])
+			}
+
+			claims := jwt.MapClaims{}
+			if _, err := jwt.ParseWithClaims(s.tokens[0], claims, func(*jwt.Token) (interface{}, error) {
+				return []byte("signkey"), nil
+			}); err != nil || claims[AuthKeyClaim] != "authkey" {
+				t.Errorf("unexpected token claims %v, err %v", claims, err)
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	s := &flakyStore{failures: 1, code: codes.ResourceExhausted, calls: map[string]int{}}
+	addr, stop := startStore(t, s)
+	defer stop()
+
+	conf := Config{Host: addr, Token: "token"}
+	conf.Retry.MaxAttempts = 1
+	c, err := New(conf)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	_, err = c.GetEvents(context.Background(), &pb.GetEventRequest{})
+	var e *Error
+	if !errors.As(err, &e) || !errors.Is(err, ResourceExhaustedErr) || e.RetryAfter != time.Second {
+		t.Errorf("expected rate limited error with retry after, got %#v", err)
+	}
+
+	s.failures = 0
+	resp, err := c.AddEvent(context.Background(), &pb.AddEventRequest{})
+	if err != nil {
+		t.Fatalf("AddEvent() error = %v", err)
+	}
+	if err := CheckResponse(resp); !errors.Is(err, UnknownErr) {
+		t.Errorf("expected error of response, got %v", err)
+	}
+
+	if _, err := New(Config{Host: addr}); err == nil {
+		t.Errorf("expected error without credentials")
+	}
+}
+
+func TestTLSConfig(t *testing.T) {
+	tt := []struct {
+		host       string
+		serverName string
+		want       string
+	}{
+		{host: "store.example.com:50051", want: "store.example.com"},
+		{host: "store.example.com", want: "store.example.com"},
+		{host: "10.0.0.1:50051", serverName: "store", want: "store"},
+	}
+	for _, tc := range tt {
+		var conf Config
+		conf.Host, conf.TLS.ServerName = tc.host, tc.serverName
+		tlsConf, err := TLSConfig(conf)
+		if err != nil {
+			t.Fatalf("TLSConfig() error = %v", err)
+		}
+		if tlsConf.ServerName != tc.want {
+			t.Errorf("expected server name %s for host %s, got %s", tc.want, tc.host, tlsConf.ServerName)
+		}
+	}
+
+	var conf Config
+	conf.TLS.CAFile = "missing.crt"
+	if _, err := TLSConfig(conf); err == nil {
+		t.Errorf("expected error on missing CA")
+	}
+}
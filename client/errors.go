@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Errors which failures of calls match by errors.Is, depending on their code
+var (
+	CanceledErr           = errors.New("call is canceled")
+	UnknownErr            = errors.New("unknown error")
+	InvalidArgumentErr    = errors.New("invalid argument")
+	DeadlineExceededErr   = errors.New("deadline exceeded")
+	NotFoundErr           = errors.New("not found")
+	AlreadyExistsErr      = errors.New("already exists")
+	PermissionDeniedErr   = errors.New("permission denied")
+	ResourceExhaustedErr  = errors.New("resource exhausted")
+	FailedPreconditionErr = errors.New("failed precondition")
+	AbortedErr            = errors.New("aborted")
+	UnimplementedErr      = errors.New("unimplemented")
+	InternalErr           = errors.New("internal error")
+	UnavailableErr        = errors.New("store is unavailable")
+	UnauthenticatedErr    = errors.New("unauthenticated")
+)
+
+var codeErrs = map[codes.Code]error{
+	codes.Canceled:           CanceledErr,
+	codes.Unknown:            UnknownErr,
+	codes.InvalidArgument:    InvalidArgumentErr,
+	codes.DeadlineExceeded:   DeadlineExceededErr,
+	codes.NotFound:           NotFoundErr,
+	codes.AlreadyExists:      AlreadyExistsErr,
+	codes.PermissionDenied:   PermissionDeniedErr,
+	codes.ResourceExhausted:  ResourceExhaustedErr,
+	codes.FailedPrecondition: FailedPreconditionErr,
+	codes.Aborted:            AbortedErr,
+	codes.Unimplemented:      UnimplementedErr,
+	codes.Internal:           InternalErr,
+	codes.Unavailable:        UnavailableErr,
+	codes.Unauthenticated:    UnauthenticatedErr,
+}
+
+// Error is a failed call, it matches the error of its code by errors.Is,
+// e.g. errors.Is(err, client.UnauthenticatedErr)
+type Error struct {
+	Code    codes.Code
+	Message string
+	// RetryAfter is the time asked by the store before calling again, when the call is rate limited
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func (e *Error) Is(target error) bool {
+	return codeErrs[e.Code] == target
+}
+
+// GRPCStatus keeps status.Code and status.FromError working on the error
+func (e *Error) GRPCStatus() *status.Status {
+	return status.New(e.Code, e.Message)
+}
+
+// response is a response which reports failures in its errorMessage field
+type response interface {
+	GetErrorMessage() string
+}
+
+// CheckResponse returns error message of the response as an error with Unknown code,
+// most calls of the store report failures this way instead of gRPC status
+func CheckResponse(resp response) error {
+	if msg := resp.GetErrorMessage(); msg != "" {
+		return &Error{Code: codes.Unknown, Message: msg}
+	}
+	return nil
+}
+
+// errorInterceptor turns failures of calls into *Error
+func errorInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var header metadata.MD
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	return &Error{Code: st.Code(), Message: st.Message(), RetryAfter: retryAfter(header)}
+}
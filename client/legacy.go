@@ -0,0 +1,148 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+// TimeFormat is the format of times which are given to the store, e.g. start time of an event
+const TimeFormat = "2006-01-02 15:04:05"
+
+// timeFormats are formats of times returned by the store, timestamp columns
+// are returned in RFC 3339, times stored as text are usually in TimeFormat
+var timeFormats = []string{time.RFC3339Nano, TimeFormat}
+
+// Event is an event with its comma separated lists and times parsed
+type Event struct {
+	Tag                string
+	Name               string
+	Status             int32
+	Frontends          []string
+	Exercises          []string
+	DisabledExercises  []string
+	Available          int32
+	Capacity           int32
+	StartedAt          time.Time
+	ExpectedFinishTime time.Time
+	FinishedAt         time.Time
+	CreatedBy          string
+	OnlyVPN            bool
+	SecretKey          string
+}
+
+// Team is a team with its solved challenges and times parsed
+type Team struct {
+	Id               string
+	Name             string
+	Email            string
+	HashPassword     string
+	CreatedAt        time.Time
+	LastAccess       time.Time
+	SolvedChallenges []SolvedChallenge
+}
+
+// SolvedChallenge is an element of solvedChallenges field of a team
+type SolvedChallenge struct {
+	Tag         string
+	CompletedAt time.Time
+}
+
+// solvedChallenge is how solved challenges are stored
+type solvedChallenge struct {
+	Tag         string `json:"tag"`
+	CompletedAt string `json:"completed-at"`
+}
+
+// ParseList splits comma separated fields, such as exercises or frontends, empty elements are left out
+func ParseList(v string) []string {
+	var list []string
+	for _, e := range strings.Split(v, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			list = append(list, e)
+		}
+	}
+	return list
+}
+
+// ParseTime parses a time returned by the store, empty time is the zero time
+func ParseTime(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	var err error
+	for _, f := range timeFormats {
+		var t time.Time
+		if t, err = time.Parse(f, v); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: %v", v, err)
+}
+
+// ParseSolvedChallenges parses solvedChallenges field of a team, which is a JSON list
+func ParseSolvedChallenges(v string) ([]SolvedChallenge, error) {
+	if strings.TrimSpace(v) == "" {
+		return nil, nil
+	}
+	var stored []solvedChallenge
+	if err := json.Unmarshal([]byte(v), &stored); err != nil {
+		return nil, fmt.Errorf("invalid solved challenges: %v", err)
+	}
+	var solved []SolvedChallenge
+	for _, c := range stored {
+		completedAt, err := ParseTime(c.CompletedAt)
+		if err != nil {
+			return nil, fmt.Errorf("challenge %s: %v", c.Tag, err)
+		}
+		solved = append(solved, SolvedChallenge{Tag: c.Tag, CompletedAt: completedAt})
+	}
+	return solved, nil
+}
+
+// ParseEvent parses fields of an event returned by GetEvents
+func ParseEvent(e *pb.GetEventResponse_Events) (Event, error) {
+	ev := Event{
+		Tag:               e.Tag,
+		Name:              e.Name,
+		Status:            e.Status,
+		Frontends:         ParseList(e.Frontends),
+		Exercises:         ParseList(e.Exercises),
+		DisabledExercises: ParseList(e.DisabledExercises),
+		Available:         e.Available,
+		Capacity:          e.Capacity,
+		CreatedBy:         e.CreatedBy,
+		OnlyVPN:           e.OnlyVPN,
+		SecretKey:         e.SecretKey,
+	}
+	var err error
+	if ev.StartedAt, err = ParseTime(e.StartedAt); err != nil {
+		return ev, fmt.Errorf("event %s: %v", e.Tag, err)
+	}
+	if ev.ExpectedFinishTime, err = ParseTime(e.ExpectedFinishTime); err != nil {
+		return ev, fmt.Errorf("event %s: %v", e.Tag, err)
+	}
+	if ev.FinishedAt, err = ParseTime(e.FinishedAt); err != nil {
+		return ev, fmt.Errorf("event %s: %v", e.Tag, err)
+	}
+	return ev, nil
+}
+
+// ParseTeam parses fields of a team returned by GetEventTeams
+func ParseTeam(t *pb.GetEventTeamsResponse_Teams) (Team, error) {
+	team := Team{Id: t.Id, Name: t.Name, Email: t.Email, HashPassword: t.HashPassword}
+	var err error
+	if team.CreatedAt, err = ParseTime(t.CreatedAt); err != nil {
+		return team, fmt.Errorf("team %s: %v", t.Id, err)
+	}
+	if team.LastAccess, err = ParseTime(t.LastAccess); err != nil {
+		return team, fmt.Errorf("team %s: %v", t.Id, err)
+	}
+	if team.SolvedChallenges, err = ParseSolvedChallenges(t.SolvedChallenges); err != nil {
+		return team, fmt.Errorf("team %s: %v", t.Id, err)
+	}
+	return team, nil
+}
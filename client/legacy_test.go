@@ -0,0 +1,76 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+func TestParseList(t *testing.T) {
+	tt := map[string][]string{
+		"":              nil,
+		"ftp":           {"ftp"},
+		"ftp,xss":       {"ftp", "xss"},
+		",ftp, xss,,wc": {"ftp", "xss", "wc"},
+	}
+	for v, want := range tt {
+		if got := ParseList(v); !reflect.DeepEqual(got, want) {
+			t.Errorf("ParseList(%q) = %v, want %v", v, got, want)
+		}
+	}
+}
+
+func TestParseTeam(t *testing.T) {
+	team, err := ParseTeam(&pb.GetEventTeamsResponse_Teams{
+		Id:               "team1",
+		CreatedAt:        "2021-06-04T10:00:00Z",
+		LastAccess:       "2021-06-04 11:00:00",
+		SolvedChallenges: `[{"tag":"ftp","completed-at":"2021-06-04 12:00:00"},{"tag":"xss","completed-at":""}]`,
+	})
+	if err != nil {
+		t.Fatalf("ParseTeam() error = %v", err)
+	}
+	want := []SolvedChallenge{
+		{Tag: "ftp", CompletedAt: time.Date(2021, 6, 4, 12, 0, 0, 0, time.UTC)},
+		{Tag: "xss"},
+	}
+	if !reflect.DeepEqual(team.SolvedChallenges, want) {
+		t.Errorf("expected solved challenges %v, got %v", want, team.SolvedChallenges)
+	}
+	if !team.CreatedAt.Equal(time.Date(2021, 6, 4, 10, 0, 0, 0, time.UTC)) || team.LastAccess.Hour() != 11 {
+		t.Errorf("unexpected times %v, %v", team.CreatedAt, team.LastAccess)
+	}
+
+	for _, solved := range []string{"not json", `[{"tag":"ftp","completed-at":"yesterday"}]`} {
+		if _, err := ParseTeam(&pb.GetEventTeamsResponse_Teams{Id: "team1", SolvedChallenges: solved}); err == nil {
+			t.Errorf("expected error on solved challenges %q", solved)
+		}
+	}
+}
+
+func TestParseEvent(t *testing.T) {
+	e, err := ParseEvent(&pb.GetEventResponse_Events{
+		Tag:                "test",
+		Frontends:          "kali",
+		Exercises:          "ftp,xss",
+		DisabledExercises:  "xss",
+		StartedAt:          "2021-06-04T10:00:00Z",
+		ExpectedFinishTime: "2021-06-05T10:00:00Z",
+	})
+	if err != nil {
+		t.Fatalf("ParseEvent() error = %v", err)
+	}
+	if !reflect.DeepEqual(e.Exercises, []string{"ftp", "xss"}) || !reflect.DeepEqual(e.DisabledExercises, []string{"xss"}) ||
+		!reflect.DeepEqual(e.Frontends, []string{"kali"}) {
+		t.Errorf("unexpected lists %+v", e)
+	}
+	if e.ExpectedFinishTime.Sub(e.StartedAt) != 24*time.Hour || !e.FinishedAt.IsZero() {
+		t.Errorf("unexpected times %+v", e)
+	}
+
+	if _, err := ParseEvent(&pb.GetEventResponse_Events{Tag: "test", FinishedAt: "tomorrow"}); err == nil {
+		t.Errorf("expected error on invalid time")
+	}
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMaxAttempts    = 3
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 2 * time.Second
+
+	// retryAfterKey is the header which tells after how many seconds a rate limited call could be retried
+	retryAfterKey = "retry-after"
+)
+
+// idempotentMethods are the calls which have the same result when they are repeated,
+// only these are retried, since a failed call might have been applied by the store
+var idempotentMethods = map[string]bool{
+	"/store.Store/GetEvents":             true,
+	"/store.Store/GetEventByUser":        true,
+	"/store.Store/GetEventTeams":         true,
+	"/store.Store/ListInactiveTeams":     true,
+	"/store.Store/GetEventStatus":        true,
+	"/store.Store/GetEventStatusHistory": true,
+	"/store.Store/IsEventExists":         true,
+	"/store.Store/GetTimeSeries":         true,
+	"/store.Store/GetUsageTimeSeries":    true,
+	"/store.Store/CheckBookingCapacity":  true,
+	"/store.Store/GetEventID":            true,
+	"/store.Store/ExportEvent":           true,
+	"/store.Store/QueryAuditLog":         true,
+	"/store.Store/GetEventStats":         true,
+	"/store.Store/GetSolveTimeline":      true,
+	"/store.Store/SetEventStatus":        true,
+	"/store.Store/UpdateTeamLastAccess":  true,
+	"/store.Store/UpdateTeamPassword":    true,
+	"/store.Store/EraseTeamData":         true,
+}
+
+// retryableCodes are failures which might not happen again
+var retryableCodes = map[codes.Code]bool{
+	codes.Unavailable:       true,
+	codes.ResourceExhausted: true,
+	codes.Aborted:           true,
+}
+
+// RetryPolicy defines how idempotent calls are retried, backoff doubles after each attempt
+// with some jitter, it is never shorter than the time asked by retry-after header.
+// Zero values are defaults, MaxAttempts of 1 disables retries.
+type RetryPolicy struct {
+	MaxAttempts    int           `yaml:"max-attempts"`
+	InitialBackoff time.Duration `yaml:"initial-backoff"`
+	MaxBackoff     time.Duration `yaml:"max-backoff"`
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = defaultMaxAttempts
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = defaultInitialBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = defaultMaxBackoff
+	}
+	return p
+}
+
+// backoff returns how long to wait before given retry, starting from 1
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < retry && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	// jitter of +-20% keeps clients from retrying together
+	return time.Duration(float64(d) * (0.8 + 0.4*rand.Float64()))
+}
+
+func (p RetryPolicy) interceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if !idempotentMethods[method] {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	for attempt := 1; ; attempt++ {
+		var header metadata.MD
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+		if err == nil || attempt >= p.MaxAttempts || !retryableCodes[status.Code(err)] {
+			return err
+		}
+
+		wait := p.backoff(attempt)
+		if after := retryAfter(header); after > wait {
+			wait = after
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+	}
+}
+
+// retryAfter reads retry-after header, which is in seconds
+func retryAfter(md metadata.MD) time.Duration {
+	for _, v := range md.Get(retryAfterKey) {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return 0
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/client"
+	"gopkg.in/yaml.v2"
+)
+
+// envPrefix is the prefix of environment variables which override
+// configuration file, e.g. host is overridden by HAAUKINS_STORE_CLI_HOST
+const envPrefix = "HAAUKINS_STORE_CLI_"
+
+// defaultConfigFile is ~/.haaukins-store-cli.yml, missing default file is not an error
+func defaultConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".haaukins-store-cli.yml")
+}
+
+// loadConfig reads client configuration file and overrides its values by environment variables,
+// path could be missing only when it is the default file
+func loadConfig(path string, optional bool) (*client.Config, error) {
+	var c client.Config
+	f, err := ioutil.ReadFile(path)
+	switch {
+	case path == "" || os.IsNotExist(err) && optional:
+	case err != nil:
+		return nil, err
+	default:
+		if err := yaml.Unmarshal(f, &c); err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+	}
+
+	env := map[string]*string{
+		"HOST":            &c.Host,
+		"AUTH_KEY":        &c.AuthKey,
+		"SIGNIN_KEY":      &c.SigninKey,
+		"TOKEN":           &c.Token,
+		"TLS_CERTFILE":    &c.TLS.CertFile,
+		"TLS_CERTKEY":     &c.TLS.CertKey,
+		"TLS_CAFILE":      &c.TLS.CAFile,
+		"TLS_SERVER_NAME": &c.TLS.ServerName,
+	}
+	for name, field := range env {
+		if v, ok := os.LookupEnv(envPrefix + name); ok {
+			*field = v
+		}
+	}
+	if v, ok := os.LookupEnv(envPrefix + "TLS_ENABLED"); ok {
+		if c.TLS.Enabled, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("%sTLS_ENABLED: %v", envPrefix, err)
+		}
+	}
+	if v, ok := os.LookupEnv(envPrefix + "TIMEOUT"); ok {
+		if c.Timeout, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("%sTIMEOUT: %v", envPrefix, err)
+		}
+	}
+
+	if c.Token == "" && (c.AuthKey == "" || c.SigninKey == "") {
+		return nil, errors.New("either token or both auth-key and signin-key should be configured")
+	}
+	return &c, nil
+}
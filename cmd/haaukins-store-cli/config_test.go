@@ -0,0 +1,43 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cli-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cli.yml")
+	conf := "host: store.example.com:50051\nauth-key: file-auth\nsignin-key: file-signin\ntls:\n  enabled: true\n"
+	if err := ioutil.WriteFile(path, []byte(conf), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	os.Setenv(envPrefix+"AUTH_KEY", "env-auth")
+	os.Setenv(envPrefix+"TIMEOUT", "3s")
+	defer os.Unsetenv(envPrefix + "AUTH_KEY")
+	defer os.Unsetenv(envPrefix + "TIMEOUT")
+
+	c, err := loadConfig(path, false)
+	if err != nil {
+		t.Fatalf("loadConfig() error = %v", err)
+	}
+	if c.Host != "store.example.com:50051" || c.AuthKey != "env-auth" || c.SigninKey != "file-signin" || !c.TLS.Enabled || c.Timeout != 3*time.Second {
+		t.Errorf("unexpected configuration %+v", c)
+	}
+
+	if _, err := loadConfig(filepath.Join(dir, "missing.yml"), false); err == nil {
+		t.Errorf("expected error when given configuration file is missing")
+	}
+	os.Unsetenv(envPrefix + "AUTH_KEY")
+	if _, err := loadConfig(filepath.Join(dir, "missing.yml"), true); err == nil {
+		t.Errorf("expected error when credentials are missing")
+	}
+}
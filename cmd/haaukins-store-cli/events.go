@@ -0,0 +1,337 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/client"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+const timeFormat = "2006-01-02 15:04:05"
+
+// event statuses as they are stored
+const (
+	runningStatus int32 = iota
+	suspendedStatus
+	bookedStatus
+	closedStatus
+	errorStatus
+)
+
+// statuses are names of event statuses by their values
+var statuses = []string{"running", "suspended", "booked", "closed", "error"}
+
+// allStatuses lists every event, it is not a status of any event
+const allStatuses = "all"
+
+func statusName(s int32) string {
+	if s >= 0 && int(s) < len(statuses) {
+		return statuses[s]
+	}
+	return strconv.Itoa(int(s))
+}
+
+// parseStatus accepts either name or value of a status
+func parseStatus(v string) (int32, error) {
+	for i, name := range statuses {
+		if strings.EqualFold(v, name) {
+			return int32(i), nil
+		}
+	}
+	if i, err := strconv.Atoi(v); err == nil && i >= 0 && i < len(statuses) {
+		return int32(i), nil
+	}
+	return 0, fmt.Errorf("invalid status %q, use one of %s", v, strings.Join(statuses, ", "))
+}
+
+func eventsTable(events []*pb.GetEventResponse_Events) table {
+	t := table{header: []string{"TAG", "NAME", "STATUS", "AVAILABLE", "CAPACITY", "STARTED", "EXPECTED FINISH", "FINISHED", "CREATED BY"}}
+	for _, e := range events {
+		t.add(e.Tag, e.Name, statusName(e.Status), strconv.Itoa(int(e.Available)), strconv.Itoa(int(e.Capacity)),
+			e.StartedAt, e.ExpectedFinishTime, e.FinishedAt, e.CreatedBy)
+	}
+	return t
+}
+
+func eventsList(a *app, args []string) error {
+	fs := flag.NewFlagSet("events list", flag.ExitOnError)
+	status := fs.String("status", allStatuses, fmt.Sprintf("status of the events, %s or %s", strings.Join(statuses, ", "), allStatuses))
+	user := fs.String("user", "", "list only events created by given user")
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	// statuses which are not known to the store list every event
+	s := int32(-1)
+	if *status != allStatuses {
+		var err error
+		if s, err = parseStatus(*status); err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var resp *pb.GetEventResponse
+	var err error
+	if *user != "" {
+		// store lists events of the user whose status is not the given one,
+		// so every event of the user is requested and filtered here
+		resp, err = a.client.GetEventByUser(ctx, &pb.GetEventByUserReq{Status: -1, User: *user})
+		if err == nil && s >= 0 {
+			resp.Events = withStatus(resp.Events, s)
+		}
+	} else {
+		resp, err = a.client.GetEvents(ctx, &pb.GetEventRequest{Status: s})
+	}
+	if err != nil {
+		return err
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+	return a.out.print(resp, eventsTable(resp.Events))
+}
+
+func withStatus(events []*pb.GetEventResponse_Events, status int32) []*pb.GetEventResponse_Events {
+	var filtered []*pb.GetEventResponse_Events
+	for _, e := range events {
+		if e.Status == status {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+func eventsGet(a *app, args []string) error {
+	fs := flag.NewFlagSet("events get", flag.ExitOnError)
+	args, err := parseArgs(fs, args, "<tag>")
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	resp, err := a.client.GetEvents(ctx, &pb.GetEventRequest{Status: -1})
+	if err != nil {
+		return err
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+
+	found := &pb.GetEventResponse{}
+	t := table{header: []string{"FIELD", "VALUE"}}
+	for _, e := range resp.Events {
+		if e.Tag != args[0] {
+			continue
+		}
+		if len(found.Events) > 0 {
+			t.add("", "")
+		}
+		found.Events = append(found.Events, e)
+		t.add("Tag", e.Tag)
+		t.add("Name", e.Name)
+		t.add("Status", statusName(e.Status))
+		t.add("Frontends", e.Frontends)
+		t.add("Exercises", e.Exercises)
+		t.add("Disabled exercises", e.DisabledExercises)
+		t.add("Available", strconv.Itoa(int(e.Available)))
+		t.add("Capacity", strconv.Itoa(int(e.Capacity)))
+		t.add("Started", e.StartedAt)
+		t.add("Expected finish", e.ExpectedFinishTime)
+		t.add("Finished", e.FinishedAt)
+		t.add("Created by", e.CreatedBy)
+		t.add("Only VPN", strconv.FormatBool(e.OnlyVPN))
+	}
+	if len(found.Events) == 0 {
+		return fmt.Errorf("no event found by tag %s", args[0])
+	}
+	return a.out.print(found, t)
+}
+
+func eventsStatus(a *app, args []string) error {
+	fs := flag.NewFlagSet("events status", flag.ExitOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 && fs.NArg() != 2 {
+		return errors.New("expected arguments: <tag> [status]")
+	}
+	tag := fs.Arg(0)
+
+	ctx := context.Background()
+	var resp *pb.EventStatusStore
+	var err error
+	if fs.NArg() == 2 {
+		var s int32
+		if s, err = parseStatus(fs.Arg(1)); err != nil {
+			return err
+		}
+		resp, err = a.client.SetEventStatus(ctx, &pb.SetEventStatusRequest{EventTag: tag, Status: s})
+	} else {
+		resp, err = a.client.GetEventStatus(ctx, &pb.GetEventStatusRequest{EventTag: tag})
+	}
+	if err != nil {
+		return err
+	}
+	return a.out.print(resp, table{header: []string{"TAG", "STATUS"}, rows: [][]string{{tag, statusName(resp.Status)}}})
+}
+
+func eventsHistory(a *app, args []string) error {
+	fs := flag.NewFlagSet("events history", flag.ExitOnError)
+	args, err := parseArgs(fs, args, "<tag>")
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	resp, err := a.client.GetEventStatusHistory(ctx, &pb.GetEventStatusRequest{EventTag: args[0]})
+	if err != nil {
+		return err
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+	t := table{header: []string{"CHANGED AT", "STATUS", "PREVIOUS", "SOURCE", "REASON"}}
+	for _, c := range resp.Changes {
+		t.add(c.ChangedAt, statusName(c.Status), statusName(c.PreviousStatus), c.Source, c.Reason)
+	}
+	return a.out.print(resp, t)
+}
+
+func eventsDrop(a *app, args []string) error {
+	fs := flag.NewFlagSet("events drop", flag.ExitOnError)
+	status := fs.String("status", statuses[bookedStatus], "status of the event, only the event with this status is dropped")
+	args, err := parseArgs(fs, args, "<tag>")
+	if err != nil {
+		return err
+	}
+	s, err := parseStatus(*status)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	resp, err := a.client.DropEvent(ctx, &pb.DropEventReq{Tag: args[0], Status: s})
+	if err != nil {
+		return err
+	}
+	if !resp.IsDropped {
+		return fmt.Errorf("event %s with status %s is not dropped", args[0], *status)
+	}
+	return a.out.print(resp, table{rows: [][]string{{fmt.Sprintf("Event %s dropped", args[0])}}})
+}
+
+// eventsClose closes an event the way Haaukins does, tag is renamed, so it could be reused
+func eventsClose(a *app, args []string) error {
+	fs := flag.NewFlagSet("events close", flag.ExitOnError)
+	newTag := fs.String("new-tag", "", "tag of the closed event, defaults to <tag>-<unix time>")
+	args, err := parseArgs(fs, args, "<tag>")
+	if err != nil {
+		return err
+	}
+	tag, now := args[0], time.Now()
+	if *newTag == "" {
+		*newTag = fmt.Sprintf("%s-%d", tag, now.Unix())
+	}
+
+	ctx := context.Background()
+	resp, err := a.client.UpdateCloseEvent(ctx, &pb.UpdateEventRequest{OldTag: tag, NewTag: *newTag, FinishedAt: now.Format(timeFormat)})
+	if err != nil {
+		return err
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+	if _, err := a.client.SetEventStatus(ctx, &pb.SetEventStatusRequest{EventTag: *newTag, Status: closedStatus}); err != nil {
+		return fmt.Errorf("event is renamed to %s, however status is not set: %v", *newTag, err)
+	}
+	return a.out.print(resp, table{rows: [][]string{{fmt.Sprintf("Event %s closed as %s", tag, *newTag)}}})
+}
+
+func eventsStats(a *app, args []string) error {
+	fs := flag.NewFlagSet("events stats", flag.ExitOnError)
+	activeSince := fs.String("active-since", "", "teams accessed after this time are active, in \"2006-01-02 15:04:05\" format")
+	from := fs.String("from", "team", "solve times are measured from creation of the team or start of the event, team or event")
+	args, err := parseArgs(fs, args, "<tag>")
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	resp, err := a.client.GetEventStats(ctx, &pb.GetEventStatsRequest{EventTag: args[0], ActiveSince: *activeSince, SolveTimeFrom: *from})
+	if err != nil {
+		return err
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+
+	t := table{header: []string{"CHALLENGE", "SOLVES", "SOLVED", "MEDIAN TIME"}}
+	t.add("(all)", strconv.Itoa(int(resp.Solves)), "", formatSeconds(resp.MedianSolveSeconds))
+	for _, c := range resp.Challenges {
+		t.add(c.Tag, strconv.Itoa(int(c.Solves)), formatRatio(c.SolvedRatio), formatSeconds(c.MedianSolveSeconds))
+	}
+	t.add("", "", "", "")
+	t.add(fmt.Sprintf("Teams: %d, active: %d, without solves: %s", resp.Teams, resp.ActiveTeams, formatRatio(resp.ZeroSolveRatio)))
+	return a.out.print(resp, t)
+}
+
+func eventsTimeline(a *app, args []string) error {
+	fs := flag.NewFlagSet("events timeline", flag.ExitOnError)
+	interval := fs.String("interval", "1h", "length of the intervals")
+	top := fs.Int("top", 10, "number of teams with most points")
+	points := fs.String("points", "", "comma separated points of challenges, e.g. ftp=2,sql=5, others are worth 1 point")
+	args, err := parseArgs(fs, args, "<tag>")
+	if err != nil {
+		return err
+	}
+	req := &pb.GetSolveTimelineRequest{EventTag: args[0], Interval: *interval, TopTeams: int32(*top), Points: map[string]int32{}}
+	for _, p := range client.ParseList(*points) {
+		kv := strings.SplitN(p, "=", 2)
+		v, err := strconv.Atoi(kv[len(kv)-1])
+		if len(kv) != 2 || err != nil {
+			return fmt.Errorf("invalid points %q, use challenge=points", p)
+		}
+		req.Points[kv[0]] = int32(v)
+	}
+
+	ctx := context.Background()
+	resp, err := a.client.GetSolveTimeline(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+
+	t := table{header: []string{"TIME", "SOLVES", "CHALLENGES"}}
+	for _, b := range resp.Buckets {
+		var challenges []string
+		for c, n := range b.Solves {
+			challenges = append(challenges, fmt.Sprintf("%s:%d", c, n))
+		}
+		sort.Strings(challenges)
+		t.add(b.Time, strconv.Itoa(int(b.Total)), strings.Join(challenges, " "))
+	}
+	t.add("", "", "")
+	t.add("TEAM", "POINTS", "NAME")
+	for _, team := range resp.Teams {
+		t.add(team.Id, strconv.Itoa(int(team.Points)), team.Name)
+	}
+	return a.out.print(resp, t)
+}
+
+func formatSeconds(s int64) string {
+	return (time.Duration(s) * time.Second).String()
+}
+
+func formatRatio(r float64) string {
+	return strconv.FormatFloat(r*100, 'f', 1, 64) + "%"
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aau-network-security/haaukins-store/client"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// fakeStore answers calls of the commands like the store does, other calls are not used
+type fakeStore struct {
+	pb.StoreClient
+	events []*pb.GetEventResponse_Events
+	teams  map[string]bool
+}
+
+func (s *fakeStore) DeleteTeam(ctx context.Context, in *pb.DelTeamRequest, opts ...grpc.CallOption) (*pb.DelTeamResp, error) {
+	if !s.teams[in.TeamId] {
+		return &pb.DelTeamResp{Message: "sql: no rows in result set", ErrorMessage: "sql: no rows in result set"}, nil
+	}
+	return &pb.DelTeamResp{Message: "Team " + in.TeamId + " is deleted"}, nil
+}
+
+// GetEventByUser lists events of the user whose status is not the requested one, as the store query does
+func (s *fakeStore) GetEventByUser(ctx context.Context, in *pb.GetEventByUserReq, opts ...grpc.CallOption) (*pb.GetEventResponse, error) {
+	resp := &pb.GetEventResponse{}
+	for _, e := range s.events {
+		if e.CreatedBy == in.User && e.Status != in.Status {
+			resp.Events = append(resp.Events, e)
+		}
+	}
+	return resp, nil
+}
+
+func TestEventsListByUser(t *testing.T) {
+	store := &fakeStore{events: []*pb.GetEventResponse_Events{
+		{Tag: "running", Status: runningStatus, CreatedBy: "alice"},
+		{Tag: "closed", Status: closedStatus, CreatedBy: "alice"},
+		{Tag: "other", Status: runningStatus, CreatedBy: "bob"},
+	}}
+
+	tt := []struct {
+		status string
+		want   []string
+	}{
+		{status: "running", want: []string{"running"}},
+		{status: "closed", want: []string{"closed"}},
+		{status: allStatuses, want: []string{"running", "closed"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.status, func(t *testing.T) {
+			var buf strings.Builder
+			out, _ := newPrinter(&buf, jsonOutput)
+			a := &app{client: &client.Client{StoreClient: store}, out: out}
+			if err := eventsList(a, []string{"-user", "alice", "-status", tc.status}); err != nil {
+				t.Fatalf("eventsList() error = %v", err)
+			}
+
+			var resp pb.GetEventResponse
+			if err := protojson.Unmarshal([]byte(buf.String()), &resp); err != nil {
+				t.Fatalf("unable to parse output: %v", err)
+			}
+			var tags []string
+			for _, e := range resp.Events {
+				if tc.status != allStatuses && statusName(e.Status) != tc.status {
+					t.Errorf("expected only %s events, got %s with status %s", tc.status, e.Tag, statusName(e.Status))
+				}
+				tags = append(tags, e.Tag)
+			}
+			if strings.Join(tags, ",") != strings.Join(tc.want, ",") {
+				t.Errorf("expected events %v, got %v", tc.want, tags)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Command haaukins-store-cli manages events and teams of a running haaukins store through its gRPC API.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aau-network-security/haaukins-store/client"
+)
+
+// command is a subcommand, name is either "group action" or a single word
+type command struct {
+	args string
+	help string
+	run  func(a *app, args []string) error
+}
+
+// app is what commands use to call the store and print responses
+type app struct {
+	client *client.Client
+	out    *printer
+}
+
+var commands = map[string]command{
+	"events list":     {args: "[-status s] [-user u]", help: "list events, all of them by default", run: eventsList},
+	"events get":      {args: "<tag>", help: "show events with given tag", run: eventsGet},
+	"events status":   {args: "<tag> [status]", help: "show status of an event or set it", run: eventsStatus},
+	"events history":  {args: "<tag>", help: "show status changes of an event", run: eventsHistory},
+	"events drop":     {args: "[-status s] <tag>", help: "delete an event with given status, booked by default", run: eventsDrop},
+	"events close":    {args: "[-new-tag t] <tag>", help: "set finish time and closed status of an event, renaming it", run: eventsClose},
+	"events stats":    {args: "[-active-since t] [-from team|event] <tag>", help: "show teams, solves and solve times of an event", run: eventsStats},
+	"events timeline": {args: "[-interval d] [-top n] [-points c=p,...] <tag>", help: "show solves in intervals and points of the top teams", run: eventsTimeline},
+
+	"teams list":           {args: "<event>", help: "list teams of an event", run: teamsList},
+	"teams add":            {args: "-id i -name n -email e [-password p] <event>", help: "add a team to an event", run: teamsAdd},
+	"teams delete":         {args: "<event> <team>", help: "delete a team of an event", run: teamsDelete},
+	"teams reset-password": {args: "[-password p] <event> <team>", help: "set password of a team, random one is generated by default", run: teamsResetPassword},
+	"teams inactive":       {args: "[-for d] <event>", help: "list teams which have not accessed an event for a while", run: teamsInactive},
+	"teams erase":          {args: "<email>", help: "anonymize teams with given email in every event", run: teamsErase},
+
+	"timeseries": {args: "[-usage] [-from t] [-to t] [-granularity g] [-group-by g]", help: "show VMs of events in time", run: timeseries},
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: haaukins-store-cli [-config file] [-o table|json|yaml] <command> [flags] [args]\n\nCommands:\n")
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		c := commands[name]
+		fmt.Fprintf(os.Stderr, "  %s %s\n    \t%s\n", name, c.args, c.help)
+	}
+	fmt.Fprintf(os.Stderr, "\nFlags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	confFile := flag.String("config", "", "configuration file, defaults to ~/.haaukins-store-cli.yml")
+	output := flag.String("o", tableOutput, "output format, table, json or yaml")
+	flag.Usage = usage
+	flag.Parse()
+
+	name, args := lookupCommand(flag.Args())
+	cmd, ok := commands[name]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+
+	if err := run(cmd, *confFile, *output, args); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", name, err)
+		os.Exit(1)
+	}
+}
+
+// lookupCommand returns name of the command given in args and its arguments
+func lookupCommand(args []string) (string, []string) {
+	if len(args) > 1 {
+		if name := args[0] + " " + args[1]; commands[name].run != nil {
+			return name, args[2:]
+		}
+	}
+	if len(args) > 0 {
+		return args[0], args[1:]
+	}
+	return "", nil
+}
+
+func run(cmd command, confFile, output string, args []string) error {
+	out, err := newPrinter(os.Stdout, output)
+	if err != nil {
+		return err
+	}
+	optional := confFile == ""
+	if optional {
+		confFile = defaultConfigFile()
+	}
+	if v, ok := os.LookupEnv(envPrefix + "CONFIG"); ok && optional {
+		confFile, optional = v, false
+	}
+	conf, err := loadConfig(confFile, optional)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(*conf)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	return cmd.run(&app{client: c, out: out}, args)
+}
+
+// parseArgs parses flags of a command and checks that exactly n arguments are given after them
+func parseArgs(fs *flag.FlagSet, args []string, names ...string) ([]string, error) {
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != len(names) {
+		return nil, fmt.Errorf("expected arguments: %s", strings.Join(names, " "))
+	}
+	return fs.Args(), nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	tableOutput = "table"
+	jsonOutput  = "json"
+	yamlOutput  = "yaml"
+)
+
+// table is what is printed in table output, JSON and YAML outputs print the response itself
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func (t *table) add(row ...string) {
+	t.rows = append(t.rows, row)
+}
+
+// printer writes responses in the chosen output format
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+func newPrinter(w io.Writer, format string) (*printer, error) {
+	switch format {
+	case tableOutput, jsonOutput, yamlOutput:
+		return &printer{w: w, format: format}, nil
+	}
+	return nil, fmt.Errorf("invalid output %q, use %s, %s or %s", format, tableOutput, jsonOutput, yamlOutput)
+}
+
+// print writes resp as JSON or YAML, or t as table
+func (p *printer) print(resp proto.Message, t table) error {
+	if p.format == tableOutput {
+		tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+		if len(t.header) > 0 {
+			fmt.Fprintln(tw, strings.Join(t.header, "\t"))
+		}
+		for _, row := range t.rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	}
+
+	// unpopulated fields are kept, so zero status or empty lists are still visible
+	out, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	if p.format == jsonOutput {
+		_, err := fmt.Fprintln(p.w, string(out))
+		return err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(out, &v); err != nil {
+		return err
+	}
+	out, err = yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = p.w.Write(out)
+	return err
+}
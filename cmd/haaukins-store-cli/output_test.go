@@ -0,0 +1,60 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+func TestPrinter(t *testing.T) {
+	resp := &pb.GetEventResponse{Events: []*pb.GetEventResponse_Events{{Tag: "test", Name: "Test Event", Capacity: 10}}}
+
+	tt := []struct {
+		format string
+		want   []string
+	}{
+		{format: tableOutput, want: []string{"TAG   NAME        STATUS   AVAILABLE  CAPACITY", "test  Test Event  running  0          10"}},
+		{format: jsonOutput, want: []string{`"tag":"test"`, `"capacity":10`, `"status":0`}},
+		{format: yamlOutput, want: []string{"events:\n- available: 0\n  capacity: 10", "  tag: test"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.format, func(t *testing.T) {
+			var buf strings.Builder
+			p, err := newPrinter(&buf, tc.format)
+			if err != nil {
+				t.Fatalf("newPrinter() error = %v", err)
+			}
+			if err := p.print(resp, eventsTable(resp.Events)); err != nil {
+				t.Fatalf("print() error = %v", err)
+			}
+			out := buf.String()
+			if tc.format == jsonOutput {
+				// spaces in protojson output are not stable
+				out = strings.Join(strings.Fields(out), "")
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("expected %q in output:\n%s", want, out)
+				}
+			}
+		})
+	}
+
+	if _, err := newPrinter(&strings.Builder{}, "xml"); err == nil {
+		t.Errorf("expected error on unknown output")
+	}
+}
+
+func TestParseStatus(t *testing.T) {
+	for v, want := range map[string]int32{"running": runningStatus, "Booked": bookedStatus, "3": closedStatus} {
+		if got, err := parseStatus(v); err != nil || got != want {
+			t.Errorf("parseStatus(%q) = %d, %v, want %d", v, got, err, want)
+		}
+	}
+	for _, v := range []string{"", "open", "5", "-1"} {
+		if _, err := parseStatus(v); err == nil {
+			t.Errorf("expected error on status %q", v)
+		}
+	}
+}
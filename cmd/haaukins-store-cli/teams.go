@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/aau-network-security/haaukins-store/client"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// solvedCount counts challenges in solvedChallenges field of a team
+func solvedCount(solved string) string {
+	challenges, err := client.ParseSolvedChallenges(solved)
+	if err != nil {
+		return "?"
+	}
+	return strconv.Itoa(len(challenges))
+}
+
+// hashPassword hashes password the same way Haaukins does, teams sign in with the plain password
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return string(hash), err
+}
+
+func randomPassword() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func teamsList(a *app, args []string) error {
+	fs := flag.NewFlagSet("teams list", flag.ExitOnError)
+	args, err := parseArgs(fs, args, "<event>")
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	resp, err := a.client.GetEventTeams(ctx, &pb.GetEventTeamsRequest{EventTag: args[0]})
+	if err != nil {
+		return err
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+	t := table{header: []string{"ID", "NAME", "EMAIL", "CREATED", "LAST ACCESS", "SOLVED"}}
+	for _, team := range resp.Teams {
+		t.add(team.Id, team.Name, team.Email, team.CreatedAt, team.LastAccess, solvedCount(team.SolvedChallenges))
+	}
+	return a.out.print(resp, t)
+}
+
+func teamsInactive(a *app, args []string) error {
+	fs := flag.NewFlagSet("teams inactive", flag.ExitOnError)
+	inactiveFor := fs.String("for", "", "inactive period, e.g. 30m, inactive-for of the store policy by default")
+	args, err := parseArgs(fs, args, "<event>")
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	resp, err := a.client.ListInactiveTeams(ctx, &pb.ListInactiveTeamsRequest{EventTag: args[0], InactiveFor: *inactiveFor})
+	if err != nil {
+		return err
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+	t := table{header: []string{"ID", "NAME", "LAST ACCESS", "INACTIVE", "FLAGGED"}}
+	for _, team := range resp.Teams {
+		t.add(team.Id, team.Name, team.LastAccess, formatSeconds(team.InactiveSeconds), team.FlaggedAt)
+	}
+	return a.out.print(resp, t)
+}
+
+func teamsErase(a *app, args []string) error {
+	fs := flag.NewFlagSet("teams erase", flag.ExitOnError)
+	args, err := parseArgs(fs, args, "<email>")
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	resp, err := a.client.EraseTeamData(ctx, &pb.EraseTeamDataRequest{Email: args[0]})
+	if err != nil {
+		return err
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+	t := table{header: []string{"EVENT", "ID"}}
+	for _, team := range resp.Teams {
+		t.add(team.EventTag, team.Id)
+	}
+	return a.out.print(resp, t)
+}
+
+func teamsAdd(a *app, args []string) error {
+	fs := flag.NewFlagSet("teams add", flag.ExitOnError)
+	id := fs.String("id", "", "id of the team")
+	name := fs.String("name", "", "name of the team")
+	email := fs.String("email", "", "email of the team")
+	password := fs.String("password", "", "password of the team, random one is generated and printed by default")
+	args, err := parseArgs(fs, args, "<event>")
+	if err != nil {
+		return err
+	}
+	if *id == "" || *name == "" || *email == "" {
+		return errors.New("-id, -name and -email are required")
+	}
+	generated := *password == ""
+	if generated {
+		if *password, err = randomPassword(); err != nil {
+			return err
+		}
+	}
+	hash, err := hashPassword(*password)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	resp, err := a.client.AddTeam(ctx, &pb.AddTeamRequest{Id: *id, EventTag: args[0], Email: *email, Name: *name, Password: hash})
+	if err != nil {
+		return err
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+	t := table{rows: [][]string{{fmt.Sprintf("Team %s added to event %s", *id, args[0])}}}
+	if generated {
+		t.add("Password: " + *password)
+	}
+	return a.out.print(resp, t)
+}
+
+func teamsDelete(a *app, args []string) error {
+	fs := flag.NewFlagSet("teams delete", flag.ExitOnError)
+	args, err := parseArgs(fs, args, "<event>", "<team>")
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	resp, err := a.client.DeleteTeam(ctx, &pb.DelTeamRequest{EvTag: args[0], TeamId: args[1]})
+	if err != nil {
+		return err
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+	return a.out.print(resp, table{rows: [][]string{{resp.Message}}})
+}
+
+func teamsResetPassword(a *app, args []string) error {
+	fs := flag.NewFlagSet("teams reset-password", flag.ExitOnError)
+	password := fs.String("password", "", "new password, random one is generated and printed by default")
+	args, err := parseArgs(fs, args, "<event>", "<team>")
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	event, err := a.client.GetEventID(ctx, &pb.GetEventIDReq{EventTag: args[0]})
+	if err != nil {
+		return err
+	}
+	if event.EventID <= 0 {
+		return fmt.Errorf("no event found by tag %s", args[0])
+	}
+	// updating password of a missing team succeeds without changing anything
+	teams, err := a.client.GetEventTeams(ctx, &pb.GetEventTeamsRequest{EventTag: args[0]})
+	if err != nil {
+		return err
+	}
+	if teams.ErrorMessage != "" {
+		return errors.New(teams.ErrorMessage)
+	}
+	found := false
+	for _, team := range teams.Teams {
+		if team.Id == args[1] {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("no team %s found in event %s", args[1], args[0])
+	}
+
+	generated := *password == ""
+	if generated {
+		if *password, err = randomPassword(); err != nil {
+			return err
+		}
+	}
+	hash, err := hashPassword(*password)
+	if err != nil {
+		return err
+	}
+	resp, err := a.client.UpdateTeamPassword(ctx, &pb.UpdateTeamPassRequest{EncryptedPass: hash, TeamID: args[1], EventID: event.EventID})
+	if err != nil {
+		return err
+	}
+	t := table{rows: [][]string{{fmt.Sprintf("Password of team %s is updated", args[1])}}}
+	if generated {
+		t.add("Password: " + *password)
+	}
+	return a.out.print(resp, t)
+}
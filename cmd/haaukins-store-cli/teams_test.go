@@ -0,0 +1,22 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aau-network-security/haaukins-store/client"
+)
+
+func TestTeamsDelete(t *testing.T) {
+	var buf strings.Builder
+	out, _ := newPrinter(&buf, tableOutput)
+	a := &app{client: &client.Client{StoreClient: &fakeStore{teams: map[string]bool{"t1": true}}}, out: out}
+
+	// success does not depend on wording of the message
+	if err := teamsDelete(a, []string{"test", "t1"}); err != nil {
+		t.Errorf("teamsDelete() error = %v", err)
+	}
+	if err := teamsDelete(a, []string{"test", "t2"}); err == nil {
+		t.Errorf("expected error on deleting missing team")
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"sort"
+	"strconv"
+
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+func timeseries(a *app, args []string) error {
+	fs := flag.NewFlagSet("timeseries", flag.ExitOnError)
+	usage := fs.Bool("usage", false, "show usage in given interval and granularity instead of VMs by day")
+	from := fs.String("from", "", "start of usage, in \"2006-01-02 15:04:05\" format")
+	to := fs.String("to", "", "end of usage, in \"2006-01-02 15:04:05\" format")
+	granularity := fs.String("granularity", "", "interval of usage, hour, day, week or month")
+	groupBy := fs.String("group-by", "", "group usage by createdBy or event")
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if !*usage {
+		resp, err := a.client.GetTimeSeries(ctx, &pb.EmptyRequest{})
+		if err != nil {
+			return err
+		}
+		var days []string
+		for day := range resp.Timeseries {
+			days = append(days, day)
+		}
+		sort.Strings(days)
+		t := table{header: []string{"DAY", "VMS"}}
+		for _, day := range days {
+			t.add(day, strconv.Itoa(int(resp.Timeseries[day])))
+		}
+		return a.out.print(resp, t)
+	}
+
+	resp, err := a.client.GetUsageTimeSeries(ctx, &pb.GetUsageTimeSeriesRequest{From: *from, To: *to, Granularity: *granularity, GroupBy: *groupBy})
+	if err != nil {
+		return err
+	}
+	if resp.ErrorMessage != "" {
+		return errors.New(resp.ErrorMessage)
+	}
+	t := table{header: []string{"TIME", "GROUP", "VMS"}}
+	for _, p := range resp.Points {
+		t.add(p.Time, p.Group, strconv.Itoa(int(p.Vms)))
+	}
+	return a.out.print(resp, t)
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	rpc "github.com/aau-network-security/haaukins-store/util"
+)
+
+// exportCommand writes archive of an event, reading it directly from the database
+func exportCommand(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	confFile := fs.String("config", defaultConfigFile, "configuration file")
+	tag := fs.String("tag", "", "tag of the event to export")
+	format := fs.String("format", rpc.JSONFormat, "archive format, json or tar.gz")
+	out := fs.String("o", "", "output file, defaults to <tag>.<format>, - writes to stdout")
+	secrets := fs.Bool("include-secrets", false, "include secret key of the event and password hashes of teams")
+	overrides := rpc.ConfigFlags(fs)
+	fs.Parse(args)
+
+	if *tag == "" {
+		return errors.New("-tag is required")
+	}
+	conf, err := rpc.NewConfigFromFile(*confFile, overrides)
+	if err != nil {
+		return err
+	}
+	store, err := database.NewStore(conf)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	if *out == "-" {
+		return rpc.ExportEvent(os.Stdout, store, *tag, *format, *secrets)
+	}
+	name := *out
+	if name == "" {
+		name = rpc.ArchiveFileName(*tag, *format)
+	}
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err := rpc.ExportEvent(f, store, *tag, *format, *secrets); err != nil {
+		f.Close()
+		os.Remove(name)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	log.Printf("Event %s exported to %s", *tag, name)
+	return nil
+}
+
+// importCommand recreates an event from its archive, writing directly into the database
+func importCommand(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	confFile := fs.String("config", defaultConfigFile, "configuration file")
+	in := fs.String("f", "", "archive file written by export, json or tar.gz, - reads from stdin")
+	tag := fs.String("tag", "", "imports the event with this tag instead of the archived one")
+	overrides := rpc.ConfigFlags(fs)
+	fs.Parse(args)
+
+	if *in == "" {
+		return errors.New("-f is required")
+	}
+	conf, err := rpc.NewConfigFromFile(*confFile, overrides)
+	if err != nil {
+		return err
+	}
+
+	r := os.Stdin
+	if *in != "-" {
+		if r, err = os.Open(*in); err != nil {
+			return err
+		}
+		defer r.Close()
+	}
+	store, err := database.NewStore(conf)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	a, err := rpc.ImportEvent(r, store, *tag)
+	if err != nil {
+		return err
+	}
+	log.Printf("Event %s imported with %d teams", a.Event.Tag, len(a.Teams))
+	return nil
+}
+
+// backupCommand dumps the whole store into a file, which is encrypted when backup.key is configured
+func backupCommand(args []string) error {
+	fs := flag.NewFlagSet("backup", flag.ExitOnError)
+	confFile := fs.String("config", defaultConfigFile, "configuration file")
+	out := fs.String("o", "", "backup file, defaults to haaukins-store-<time>.backup")
+	overrides := rpc.ConfigFlags(fs)
+	fs.Parse(args)
+
+	conf, err := rpc.NewConfigFromFile(*confFile, overrides)
+	if err != nil {
+		return err
+	}
+	store, err := database.NewStore(conf)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	name := *out
+	if name == "" {
+		name = fmt.Sprintf("haaukins-store-%s.backup", time.Now().UTC().Format("20060102-150405"))
+	}
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	b, err := rpc.WriteBackup(f, store, conf.Backup.Key)
+	if err != nil {
+		f.Close()
+		os.Remove(name)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	log.Printf("Backup of %d events and %d audit entries is written to %s (encrypted: %t)", len(b.Events), len(b.AuditLog), name, conf.Backup.Key != "")
+	return nil
+}
+
+// restoreCommand writes a backup into the configured database, which should be empty
+func restoreCommand(args []string) error {
+	fs := flag.NewFlagSet("restore", flag.ExitOnError)
+	confFile := fs.String("config", defaultConfigFile, "configuration file")
+	in := fs.String("f", "", "backup file")
+	overrides := rpc.ConfigFlags(fs)
+	fs.Parse(args)
+
+	if *in == "" {
+		return errors.New("-f is required")
+	}
+	conf, err := rpc.NewConfigFromFile(*confFile, overrides)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(*in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	store, err := database.NewStore(conf)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	b, err := rpc.RestoreBackup(f, store, conf.Backup.Key)
+	if err != nil {
+		return err
+	}
+	log.Printf("Restored %d events and %d audit entries from backup created at %s", len(b.Events), len(b.AuditLog), b.CreatedAt.Format(database.TimeFormat))
+	return nil
+}
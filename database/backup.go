@@ -0,0 +1,90 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aau-network-security/haaukins-store/model"
+)
+
+var NotEmptyErr = errors.New("store is not empty, backups could only be restored into an empty database")
+
+// Backup reads every event with its teams and status history, and the audit log
+// in one read only transaction, events are in the order they are created
+func (s *store) Backup() (model.Backup, error) {
+	s.lock()
+	defer s.m.Unlock()
+
+	var b model.Backup
+	tx, err := beginSnapshot(s.db)
+	if err != nil {
+		return b, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(QueryAllEvents)
+	if err != nil {
+		return b, fmt.Errorf("query events err %w", err)
+	}
+	events, err := parseEvents(rows)
+	rows.Close()
+	if err != nil {
+		return b, err
+	}
+	for _, e := range events {
+		a, err := exportEvent(tx, e)
+		if err != nil {
+			return b, fmt.Errorf("event %s: %v", e.Tag, err)
+		}
+		b.Events = append(b.Events, a)
+	}
+
+	rows, err = tx.Query(QueryAllAuditLog)
+	if err != nil {
+		return b, fmt.Errorf("query audit log err %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var e model.AuditEntry
+		if err := rows.Scan(&e.CreatedAt, &e.Method, &e.Caller, &e.EventTag, &e.TeamId, &e.Request, &e.Code, &e.ErrorMessage); err != nil {
+			return b, err
+		}
+		b.AuditLog = append(b.AuditLog, e)
+	}
+	if err := rows.Err(); err != nil {
+		return b, err
+	}
+	return b, tx.Commit()
+}
+
+// Restore writes everything in the backup in one transaction, it fails unless the store is empty
+func (s *store) Restore(b model.Backup) error {
+	s.lock()
+	defer s.m.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var empty bool
+	if err := tx.QueryRow(QueryStoreEmpty).Scan(&empty); err != nil {
+		return err
+	}
+	if !empty {
+		return NotEmptyErr
+	}
+
+	for _, a := range b.Events {
+		if err := importEvent(tx, a); err != nil {
+			return err
+		}
+	}
+	for _, e := range b.AuditLog {
+		if _, err := tx.Exec(AddAuditLogQuery, e.CreatedAt, e.Method, e.Caller, e.EventTag, e.TeamId, e.Request, e.Code, e.ErrorMessage); err != nil {
+			return fmt.Errorf("insert audit log err %w", err)
+		}
+	}
+	return tx.Commit()
+}
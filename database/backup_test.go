@@ -0,0 +1,53 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+func TestBackupRestore(t *testing.T) {
+	db, err := setup()
+	if err != nil {
+		t.Fatalf("Setting up env error %v ", err)
+	}
+	s := &store{db: db}
+
+	now := time.Date(2021, 6, 4, 12, 0, 0, 0, time.UTC)
+	for _, tag := range []string{"first", "second"} {
+		if err := insertFakeEvent(fakeEvent{tag: tag, sT: now, fT: now.Add(time.Hour)}, db); err != nil {
+			t.Fatalf("insertFakeEvent error %v", err)
+		}
+	}
+	if _, err := s.AddTeam(&pb.AddTeamRequest{Id: "team1", EventTag: "first", Name: "Team 1"}); err != nil {
+		t.Fatalf("AddTeam() error = %v", err)
+	}
+	if err := s.AddAuditEntry(model.AuditEntry{CreatedAt: now, Method: "AddTeam", EventTag: "first"}); err != nil {
+		t.Fatalf("AddAuditEntry() error = %v", err)
+	}
+
+	b, err := s.Backup()
+	if err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+	if len(b.Events) != 2 || b.Events[0].Event.Tag != "first" || len(b.Events[0].Teams) != 1 || len(b.AuditLog) != 1 {
+		t.Fatalf("unexpected backup %+v", b)
+	}
+
+	if err := s.Restore(b); err != NotEmptyErr {
+		t.Errorf("Restore() error = %v, want %v", err, NotEmptyErr)
+	}
+
+	if err := cleanRecords(db); err != nil {
+		t.Fatalf("cleanRecords error %v", err)
+	}
+	if err := s.Restore(b); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	teams, err := s.GetTeams("first")
+	if err != nil || len(teams) != 1 {
+		t.Errorf("expected restored team, got %v, err %v", teams, err)
+	}
+}
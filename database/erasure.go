@@ -0,0 +1,73 @@
+package database
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	"github.com/lib/pq"
+)
+
+// AnonymizedName replaces names of anonymized teams
+const AnonymizedName = "anonymized"
+
+// EraseTeamData anonymizes teams with given email in every event and removes it
+// from audit log, given audit entry is recorded for each team in the same transaction
+func (s *store) EraseTeamData(email string, audit model.AuditEntry) ([]model.ErasedTeam, error) {
+	s.lock()
+	defer s.m.Unlock()
+	return eraseTeams(s.db, audit, EraseTeamData, email, AnonymizedName)
+}
+
+// AnonymizeTeams anonymizes teams of events which finished before given time,
+// given audit entry is recorded for each team in the same transaction
+func (s *store) AnonymizeTeams(finishedBefore time.Time, audit model.AuditEntry) ([]model.ErasedTeam, error) {
+	s.lock()
+	defer s.m.Unlock()
+	return eraseTeams(s.db, audit, AnonymizeFinishedTeams, finishedBefore, AnonymizedName)
+}
+
+// eraseTeams runs the query which anonymizes teams and returns their event and team tags,
+// requests which added them are removed from audit log and the erasure is recorded instead
+func eraseTeams(db *sql.DB, audit model.AuditEntry, query string, args ...interface{}) ([]model.ErasedTeam, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	var teams []model.ErasedTeam
+	var ids []string
+	for rows.Next() {
+		var t model.ErasedTeam
+		if err := rows.Scan(&t.EventTag, &t.Id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		teams = append(teams, t)
+		ids = append(ids, t.Id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(teams) == 0 {
+		return nil, nil
+	}
+
+	if _, err := tx.Exec(EraseAuditRequests, pq.Array(ids)); err != nil {
+		return nil, err
+	}
+	for _, t := range teams {
+		e := audit
+		e.EventTag, e.TeamId = t.EventTag, t.Id
+		if _, err := tx.Exec(AddAuditLogQuery, e.CreatedAt, e.Method, e.Caller, e.EventTag, e.TeamId, e.Request, e.Code, e.ErrorMessage); err != nil {
+			return nil, err
+		}
+	}
+	return teams, tx.Commit()
+}
@@ -0,0 +1,245 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	"github.com/lib/pq"
+)
+
+// archiveTimeFormats are accepted formats of timestamps in archives, exported
+// timestamps are in RFC 3339, the ones written by hand could be in TimeFormat
+var archiveTimeFormats = []string{time.RFC3339Nano, TimeFormat}
+
+// ExportEvent reads the most recent event with given tag, its teams, their solves
+// and status history in one read only transaction, so the archive is consistent.
+// Secret key and password hashes are included, callers decide whether to keep them.
+func (s *store) ExportEvent(tag string) (model.EventArchive, error) {
+	s.lock()
+	defer s.m.Unlock()
+
+	var a model.EventArchive
+	tx, err := beginSnapshot(s.db)
+	if err != nil {
+		return a, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(QueryLatestEventByTag, tag)
+	if err != nil {
+		return a, fmt.Errorf("query event err %w", err)
+	}
+	events, err := parseEvents(rows)
+	rows.Close()
+	if err != nil {
+		return a, err
+	}
+	if len(events) == 0 {
+		return a, fmt.Errorf("no event found by tag %s", tag)
+	}
+
+	if a, err = exportEvent(tx, events[0]); err != nil {
+		return a, err
+	}
+	return a, tx.Commit()
+}
+
+// ImportEvent recreates the archived event with its teams, solves and status history in one transaction,
+// it fails when an event which is not closed has the same tag or some of the team ids are already used
+func (s *store) ImportEvent(a model.EventArchive) error {
+	s.lock()
+	defer s.m.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	if err := tx.QueryRow(QueryIsEventExist, a.Event.Tag, int32(Closed)).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("event with tag %s already exists", a.Event.Tag)
+	}
+	var teamTags []string
+	for _, t := range a.Teams {
+		teamTags = append(teamTags, t.Id)
+	}
+	used, err := queryTags(tx, QueryExistingTeamTags, pq.Array(teamTags))
+	if err != nil {
+		return err
+	}
+	if len(used) > 0 {
+		return fmt.Errorf("team ids are already used: %s", strings.Join(used, ", "))
+	}
+
+	if err := importEvent(tx, a); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// beginSnapshot starts a read only transaction which sees the same data until it ends
+func beginSnapshot(db *sql.DB) (*sql.Tx, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec("SET TRANSACTION ISOLATION LEVEL REPEATABLE READ READ ONLY"); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return tx, nil
+}
+
+// exportEvent reads teams, solves and status history of the event
+func exportEvent(q querier, e model.Event) (model.EventArchive, error) {
+	a := model.EventArchive{Event: model.ArchivedEvent{
+		Tag:                e.Tag,
+		Name:               e.Name,
+		Frontends:          e.Frontends,
+		Exercises:          e.Exercises,
+		DisabledExercises:  e.DisabledExercises,
+		Available:          e.Available,
+		Capacity:           e.Capacity,
+		Status:             e.Status,
+		StartedAt:          e.StartedAt,
+		ExpectedFinishTime: e.ExpectedFinishTime,
+		FinishedAt:         e.FinishedAt,
+		CreatedBy:          e.CreatedBy,
+		OnlyVPN:            e.OnlyVPN,
+		SecretKey:          e.SecretKey,
+	}}
+
+	rows, err := q.Query(QueryEventTeams, e.Id)
+	if err != nil {
+		return a, fmt.Errorf("query teams err %w", err)
+	}
+	teams, err := parseTeams(rows)
+	rows.Close()
+	if err != nil {
+		return a, err
+	}
+	for _, t := range teams {
+		solves, err := parseSolves(t.SolvedChallenges)
+		if err != nil {
+			return a, fmt.Errorf("solved challenges of team %s: %v", t.Tag, err)
+		}
+		a.Teams = append(a.Teams, model.ArchivedTeam{
+			Id:         t.Tag,
+			Name:       t.Name,
+			Email:      t.Email,
+			Password:   t.Password,
+			CreatedAt:  t.CreatedAt,
+			LastAccess: t.LastAccess,
+			Solves:     solves,
+		})
+	}
+
+	a.StatusHistory, err = eventStatusHistory(q, e.Id)
+	return a, err
+}
+
+// importEvent inserts the archived event, its teams and status history
+func importEvent(tx *sql.Tx, a model.EventArchive) error {
+	e := a.Event
+	startedAt, err := ParseArchiveTime(e.StartedAt)
+	if err != nil {
+		return err
+	}
+	expectedFinish, err := ParseArchiveTime(e.ExpectedFinishTime)
+	if err != nil {
+		return err
+	}
+	finishedAt, err := ParseArchiveTime(e.FinishedAt)
+	if err != nil {
+		return err
+	}
+	var eventId int
+	if err := tx.QueryRow(ImportEventQuery, e.Tag, e.Name, e.Available, e.Capacity, e.Frontends, e.Status, e.Exercises,
+		startedAt, expectedFinish, finishedAt, e.CreatedBy, e.OnlyVPN, e.SecretKey, e.DisabledExercises).Scan(&eventId); err != nil {
+		return fmt.Errorf("insert event %s err %w", e.Tag, err)
+	}
+
+	for _, t := range a.Teams {
+		createdAt, err := ParseArchiveTime(t.CreatedAt)
+		if err != nil {
+			return err
+		}
+		lastAccess, err := ParseArchiveTime(t.LastAccess)
+		if err != nil {
+			return err
+		}
+		solved := []solvedChallenge{}
+		for _, c := range t.Solves {
+			solved = append(solved, solvedChallenge{Tag: c.Challenge, CompletedAt: c.CompletedAt})
+		}
+		solvedColumn, _ := json.Marshal(solved)
+		if _, err := tx.Exec(ImportTeamQuery, t.Id, eventId, t.Email, t.Name, t.Password, createdAt, lastAccess, string(solvedColumn)); err != nil {
+			return fmt.Errorf("insert team %s err %w", t.Id, err)
+		}
+	}
+
+	for _, c := range a.StatusHistory {
+		if _, err := tx.Exec(ImportStatusHistoryQuery, eventId, e.Tag, c.Status, c.PreviousStatus, c.ChangedAt, c.Source, c.Reason); err != nil {
+			return fmt.Errorf("insert status history of event %s err %w", e.Tag, err)
+		}
+	}
+	return nil
+}
+
+// ParseArchiveTime parses timestamp of an archive, empty timestamp is the zero time,
+// which means not set, e.g. event is not finished yet
+func ParseArchiveTime(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	var err error
+	for _, f := range archiveTimeFormats {
+		var t time.Time
+		if t, err = time.Parse(f, v); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: %v", v, err)
+}
+
+// parseSolves reads solved_challenges column of a team, empty column means no solves
+func parseSolves(column string) ([]model.ArchivedSolve, error) {
+	solves := []model.ArchivedSolve{}
+	if column == "" {
+		return solves, nil
+	}
+	var solved []solvedChallenge
+	if err := json.Unmarshal([]byte(column), &solved); err != nil {
+		return nil, err
+	}
+	for _, c := range solved {
+		solves = append(solves, model.ArchivedSolve{Challenge: c.Tag, CompletedAt: c.CompletedAt})
+	}
+	return solves, nil
+}
+
+func eventStatusHistory(q querier, eventId uint) ([]model.ArchivedStatus, error) {
+	rows, err := q.Query(QueryEventStatusHistory, eventId)
+	if err != nil {
+		return nil, fmt.Errorf("query status history err %w", err)
+	}
+	defer rows.Close()
+
+	history := []model.ArchivedStatus{}
+	for rows.Next() {
+		var c model.ArchivedStatus
+		if err := rows.Scan(&c.Status, &c.PreviousStatus, &c.ChangedAt, &c.Source, &c.Reason); err != nil {
+			return nil, err
+		}
+		history = append(history, c)
+	}
+	return history, rows.Err()
+}
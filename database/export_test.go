@@ -0,0 +1,84 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+func TestExportEvent(t *testing.T) {
+	db, err := setup()
+	if err != nil {
+		t.Fatalf("Setting up env error %v ", err)
+	}
+	s := &store{db: db}
+
+	now := time.Date(2021, 6, 4, 12, 0, 0, 0, time.UTC)
+	if err := insertFakeEvent(fakeEvent{tag: "export", sT: now, fT: now.Add(time.Hour)}, db); err != nil {
+		t.Fatalf("insertFakeEvent error %v", err)
+	}
+	if _, err := s.AddTeam(&pb.AddTeamRequest{Id: "team1", EventTag: "export", Email: "team1@example.com", Name: "Team 1", Password: "hash"}); err != nil {
+		t.Fatalf("AddTeam() error = %v", err)
+	}
+	if _, err := s.UpdateTeamSolvedChallenge(&pb.UpdateTeamSolvedChallengeRequest{TeamId: "team1", Tag: "ftp", CompletedAt: "2021-06-04 12:30:00"}); err != nil {
+		t.Fatalf("UpdateTeamSolvedChallenge() error = %v", err)
+	}
+	if _, err := s.SetEventStatus(&pb.SetEventStatusRequest{EventTag: "export", Status: int32(Running)}); err != nil {
+		t.Fatalf("SetEventStatus() error = %v", err)
+	}
+
+	a, err := s.ExportEvent("export")
+	if err != nil {
+		t.Fatalf("ExportEvent() error = %v", err)
+	}
+	if a.Event.Tag != "export" || len(a.Teams) != 1 || len(a.StatusHistory) != 1 {
+		t.Fatalf("unexpected archive %+v", a)
+	}
+	if solves := a.Teams[0].Solves; len(solves) != 1 || solves[0].Challenge != "ftp" {
+		t.Errorf("unexpected solves %+v", solves)
+	}
+
+	if _, err := s.ExportEvent("unknown"); err == nil {
+		t.Errorf("expected error on unknown event")
+	}
+}
+
+func TestImportEvent(t *testing.T) {
+	db, err := setup()
+	if err != nil {
+		t.Fatalf("Setting up env error %v ", err)
+	}
+	s := &store{db: db}
+
+	now := time.Date(2021, 6, 4, 12, 0, 0, 0, time.UTC)
+	if err := insertFakeEvent(fakeEvent{tag: "source", sT: now, fT: now.Add(time.Hour)}, db); err != nil {
+		t.Fatalf("insertFakeEvent error %v", err)
+	}
+	if _, err := s.AddTeam(&pb.AddTeamRequest{Id: "team1", EventTag: "source", Name: "Team 1"}); err != nil {
+		t.Fatalf("AddTeam() error = %v", err)
+	}
+	a, err := s.ExportEvent("source")
+	if err != nil {
+		t.Fatalf("ExportEvent() error = %v", err)
+	}
+
+	// active event with the same tag exists
+	if err := s.ImportEvent(a); err == nil {
+		t.Errorf("expected error on tag collision")
+	}
+	// team ids are already used by the source event
+	a.Event.Tag = "copy"
+	if err := s.ImportEvent(a); err == nil {
+		t.Errorf("expected error on team id collision")
+	}
+
+	a.Teams[0].Id = "team2"
+	if err := s.ImportEvent(a); err != nil {
+		t.Fatalf("ImportEvent() error = %v", err)
+	}
+	teams, err := s.GetTeams("copy")
+	if err != nil || len(teams) != 1 || teams[0].Tag != "team2" {
+		t.Errorf("expected imported team, got %v, err %v", teams, err)
+	}
+}
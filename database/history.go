@@ -0,0 +1,151 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/model"
+)
+
+const (
+	APISource       = "api"
+	SchedulerSource = "scheduler"
+
+	StartedReason     = "start time reached"
+	ClosedReason      = "expected finish time passed"
+	DueForCloseReason = "due for close"
+
+	// leaderConnTimeout limits waiting for a connection to hold locks on
+	leaderConnTimeout = 5 * time.Second
+)
+
+// StartBookedEvents sets booked events which should have been started as running,
+// it returns tags of the started events
+func (s *store) StartBookedEvents(now time.Time) ([]string, error) {
+	s.lock()
+	defer s.m.Unlock()
+	return queryTags(s.db, StartBookedEvents, now, SchedulerSource, StartedReason)
+}
+
+// ExpireEvents either closes or marks as due for close running and suspended events
+// which are expected to finish before given time, it returns tags of the events.
+// Closed events are renamed to <tag>-<unix time> of now, returned tags are the previous ones.
+func (s *store) ExpireEvents(now, before time.Time, close bool) ([]string, error) {
+	s.lock()
+	defer s.m.Unlock()
+	if close {
+		return queryTags(s.db, CloseExpiredEvents, now, before, SchedulerSource, ClosedReason)
+	}
+	return queryTags(s.db, MarkExpiredEvents, now, before, SchedulerSource, DueForCloseReason)
+}
+
+func (s *store) GetStatusHistory(tag string) ([]model.StatusChange, error) {
+	s.lock()
+	defer s.m.Unlock()
+
+	rows, err := s.db.Query(QueryStatusHistory, tag)
+	if err != nil {
+		return nil, fmt.Errorf("query status history err %w", err)
+	}
+	defer rows.Close()
+
+	var history []model.StatusChange
+	for rows.Next() {
+		var c model.StatusChange
+		if err := rows.Scan(&c.Status, &c.PreviousStatus, &c.ChangedAt, &c.Source, &c.Reason); err != nil {
+			return nil, err
+		}
+		history = append(history, c)
+	}
+	return history, rows.Err()
+}
+
+// Leader reports whether this store holds the lock with given name,
+// it tries to take the lock when it is not held. Every lock is held by one
+// dedicated session, so the workers pin a single connection of the pool,
+// and locks are released when the session is lost or store is closed.
+func (s *store) Leader(ctx context.Context, name string) (bool, error) {
+	s.leaderM.Lock()
+	defer s.leaderM.Unlock()
+
+	if s.session != nil {
+		if _, err := s.session.ExecContext(ctx, "SELECT 1"); err != nil {
+			// locks are released together with the lost session
+			s.closeSession()
+		}
+	}
+	if s.held[name] {
+		return true, nil
+	}
+
+	if s.session == nil {
+		// pool might be exhausted, waiting is limited so other workers are not blocked for long
+		connCtx, cancel := context.WithTimeout(ctx, leaderConnTimeout)
+		defer cancel()
+		conn, err := s.db.Conn(connCtx)
+		if err != nil {
+			return false, err
+		}
+		s.session = conn
+	}
+	var acquired bool
+	if err := s.session.QueryRowContext(ctx, QueryTryAdvisoryLock, name).Scan(&acquired); err != nil || !acquired {
+		// session is not kept when it holds no lock
+		if len(s.held) == 0 {
+			s.closeSession()
+		}
+		return false, err
+	}
+	if s.held == nil {
+		s.held = map[string]bool{}
+	}
+	s.held[name] = true
+	return true, nil
+}
+
+// closeSession discards the connection of the session instead of returning it to the pool,
+// so locks are released by the database even when the session failed for another reason
+// than a lost connection, e.g. a cancelled context, and could still hold them
+func (s *store) closeSession() {
+	if s.session != nil {
+		s.session.Raw(func(interface{}) error {
+			return driver.ErrBadConn
+		})
+		s.session.Close()
+	}
+	s.session = nil
+	s.held = nil
+}
+
+// releaseLeadership closes the session holding locks, which releases them
+func (s *store) releaseLeadership() {
+	s.leaderM.Lock()
+	defer s.leaderM.Unlock()
+	s.closeSession()
+}
+
+// querier is either the database or a transaction
+type querier interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+}
+
+func queryTags(db querier, query string, args ...interface{}) ([]string, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []string
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, rows.Err()
+}
@@ -0,0 +1,183 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+func TestEventTransitions(t *testing.T) {
+	db, err := setup()
+	if err != nil {
+		t.Fatalf("Setting up env error %v ", err)
+	}
+	s := &store{db: db}
+
+	now := time.Date(2021, 6, 4, 12, 0, 0, 0, time.UTC)
+	fEvents := []fakeEvent{
+		{tag: "due", sT: now.Add(-time.Hour), fT: now.Add(time.Hour)},
+		{tag: "future", sT: now.Add(time.Hour), fT: now.Add(2 * time.Hour)},
+		{tag: "expired", sT: now.Add(-2 * time.Hour), fT: now.Add(-time.Hour)},
+	}
+	for _, e := range fEvents {
+		if err := insertFakeEvent(e, db); err != nil {
+			t.Fatalf("insertFakeEvent error on events for event %s %v", e.tag, err)
+		}
+	}
+	if _, err := db.Exec("UPDATE event SET status=$1 WHERE tag != 'expired'", Booked); err != nil {
+		t.Fatalf("booking events error %v", err)
+	}
+
+	started, err := s.StartBookedEvents(now)
+	if err != nil {
+		t.Fatalf("StartBookedEvents() error = %v", err)
+	}
+	if len(started) != 1 || started[0] != "due" {
+		t.Errorf("StartBookedEvents() got = %v, want [due]", started)
+	}
+
+	// expired events are marked only once
+	for i := 0; i < 2; i++ {
+		marked, err := s.ExpireEvents(now, now, false)
+		if err != nil {
+			t.Fatalf("ExpireEvents() error = %v", err)
+		}
+		if want := 1 - i; len(marked) != want {
+			t.Errorf("ExpireEvents() marked %v, want %d events", marked, want)
+		}
+	}
+
+	closed, err := s.ExpireEvents(now, now, true)
+	if err != nil {
+		t.Fatalf("ExpireEvents() error = %v", err)
+	}
+	if len(closed) != 1 || closed[0] != "expired" {
+		t.Errorf("ExpireEvents() closed %v, want [expired]", closed)
+	}
+	closedTag := fmt.Sprintf("expired-%d", now.Unix())
+	if status, _ := s.GetEventStatus(&pb.GetEventStatusRequest{EventTag: closedTag}); status != int32(Closed) {
+		t.Errorf("expected expired event to be closed as %s, but status is %d", closedTag, status)
+	}
+
+	history, err := s.GetStatusHistory(closedTag)
+	if err != nil {
+		t.Fatalf("GetStatusHistory() error = %v", err)
+	}
+	if len(history) != 2 || history[0].Reason != DueForCloseReason || history[1].Reason != ClosedReason || history[1].PreviousStatus != int32(Suspended) {
+		t.Errorf("GetStatusHistory() got = %v", history)
+	}
+
+	// status changes by API are recorded as well
+	if _, err := s.SetEventStatus(&pb.SetEventStatusRequest{EventTag: "future", Status: int32(Running)}); err != nil {
+		t.Fatalf("SetEventStatus() error = %v", err)
+	}
+	history, err = s.GetStatusHistory("future")
+	if err != nil {
+		t.Fatalf("GetStatusHistory() error = %v", err)
+	}
+	if len(history) != 1 || history[0].Source != APISource || history[0].PreviousStatus != int32(Booked) {
+		t.Errorf("GetStatusHistory() got = %v", history)
+	}
+}
+
+func TestCloseExpiredReusedTag(t *testing.T) {
+	db, err := setup()
+	if err != nil {
+		t.Fatalf("Setting up env error %v ", err)
+	}
+	s := &store{db: db}
+
+	now := time.Date(2021, 6, 4, 12, 0, 0, 0, time.UTC)
+	if err := insertFakeEvent(fakeEvent{tag: "reused", sT: now.Add(-2 * time.Hour), fT: now.Add(-time.Hour)}, db); err != nil {
+		t.Fatalf("insertFakeEvent error %v", err)
+	}
+	if _, err := db.Exec("UPDATE event SET status=$1 WHERE tag = 'reused'", Running); err != nil {
+		t.Fatalf("running event error %v", err)
+	}
+	if closed, err := s.ExpireEvents(now, now, true); err != nil || len(closed) != 1 {
+		t.Fatalf("ExpireEvents() closed %v, error = %v", closed, err)
+	}
+
+	// tag of the closed event is free, a new event could use it
+	if exists, _ := s.IsEventExists(&pb.GetEventByTagReq{EventTag: "reused", Status: int32(Closed)}); exists {
+		t.Fatalf("expected tag of the closed event to be free")
+	}
+	if err := insertFakeEvent(fakeEvent{tag: "reused", sT: now, fT: now.Add(time.Hour)}, db); err != nil {
+		t.Fatalf("insertFakeEvent error %v", err)
+	}
+	if _, err := s.SetEventStatus(&pb.SetEventStatusRequest{EventTag: "reused", Status: int32(Running)}); err != nil {
+		t.Fatalf("SetEventStatus() error = %v", err)
+	}
+
+	closedTag := fmt.Sprintf("reused-%d", now.Unix())
+	if status, _ := s.GetEventStatus(&pb.GetEventStatusRequest{EventTag: closedTag}); status != int32(Closed) {
+		t.Errorf("expected closed event to stay closed, but status is %d", status)
+	}
+	history, err := s.GetStatusHistory(closedTag)
+	if err != nil {
+		t.Fatalf("GetStatusHistory() error = %v", err)
+	}
+	if len(history) != 1 || history[0].Status != int32(Closed) {
+		t.Errorf("expected only the close in history of the closed event, got %v", history)
+	}
+	if status, _ := s.GetEventStatus(&pb.GetEventStatusRequest{EventTag: "reused"}); status != int32(Running) {
+		t.Errorf("expected new event to be running, but status is %d", status)
+	}
+}
+
+func TestLeader(t *testing.T) {
+	db, err := setup()
+	if err != nil {
+		t.Fatalf("Setting up env error %v ", err)
+	}
+	first, second := &store{db: db}, &store{db: db}
+	defer first.releaseLeadership()
+	defer second.releaseLeadership()
+
+	if leader, err := first.Leader(context.Background(), "test"); err != nil || !leader {
+		t.Fatalf("expected first store to lead, but received %v %v", leader, err)
+	}
+	if leader, err := second.Leader(context.Background(), "test"); err != nil || leader {
+		t.Fatalf("expected second store not to lead, but received %v %v", leader, err)
+	}
+	if second.session != nil {
+		t.Errorf("expected second store not to keep a session without locks")
+	}
+	// every lock of a store is held by the same session
+	if leader, err := first.Leader(context.Background(), "other"); err != nil || !leader || len(first.held) != 2 {
+		t.Fatalf("expected first store to hold both locks, but received %v %v %v", leader, err, first.held)
+	}
+	first.releaseLeadership()
+	if leader, err := second.Leader(context.Background(), "test"); err != nil || !leader {
+		t.Fatalf("expected second store to lead after first one released, but received %v %v", leader, err)
+	}
+}
+
+func TestLeaderCancelled(t *testing.T) {
+	db, err := setup()
+	if err != nil {
+		t.Fatalf("Setting up env error %v ", err)
+	}
+	s := &store{db: db}
+	defer s.releaseLeadership()
+
+	if leader, err := s.Leader(context.Background(), "test"); err != nil || !leader {
+		t.Fatalf("expected store to lead, but received %v %v", leader, err)
+	}
+	// session fails on cancelled context while its connection is still fine
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if leader, _ := s.Leader(ctx, "test"); leader {
+		t.Fatalf("expected store to give up leadership with the failed session")
+	}
+	var locks int
+	if err := db.QueryRow("SELECT count(*) FROM pg_locks WHERE locktype = 'advisory'").Scan(&locks); err != nil {
+		t.Fatal(err)
+	}
+	if locks != 0 {
+		t.Errorf("expected locks to be released with the discarded session, but %d are held", locks)
+	}
+}
@@ -0,0 +1,65 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/model"
+)
+
+// GetInactiveTeams returns teams of the most recent event with given tag
+// which have not accessed the event since given time
+func (s *store) GetInactiveTeams(tag string, before time.Time) ([]model.InactiveTeam, error) {
+	s.lock()
+	defer s.m.Unlock()
+
+	teams, err := queryInactiveTeams(s.db, QueryInactiveTeams, tag, before)
+	if err != nil {
+		return nil, fmt.Errorf("query inactive teams err %w", err)
+	}
+	return teams, nil
+}
+
+// FlagInactiveTeams flags teams of running events which have not accessed since given time,
+// flags of teams which accessed again are removed. It returns only the newly flagged teams,
+// so a team is reported once until it becomes active again.
+func (s *store) FlagInactiveTeams(now, before time.Time) ([]model.InactiveTeam, error) {
+	s.lock()
+	defer s.m.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(ClearInactiveTeams); err != nil {
+		return nil, err
+	}
+	teams, err := queryInactiveTeams(tx, FlagInactiveTeams, now, before)
+	if err != nil {
+		return nil, err
+	}
+	return teams, tx.Commit()
+}
+
+func queryInactiveTeams(db querier, query string, args ...interface{}) ([]model.InactiveTeam, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []model.InactiveTeam
+	for rows.Next() {
+		var t model.InactiveTeam
+		var flaggedAt sql.NullTime
+		if err := rows.Scan(&t.EventTag, &t.Id, &t.Name, &t.LastAccess, &flaggedAt); err != nil {
+			return nil, err
+		}
+		t.FlaggedAt = flaggedAt.Time
+		teams = append(teams, t)
+	}
+	return teams, rows.Err()
+}
@@ -6,6 +6,9 @@ import (
 	_ "github.com/lib/pq"
 )
 
+// tables are created by InitTables, store is not usable until all of them exist
+var tables = []string{"event", "team", "audit_log", "event_status_history", "inactive_team"}
+
 func InitTables(db *sql.DB) error {
 
 	if _, err := createTables(db); err != nil {
@@ -26,5 +29,35 @@ func createTables(db *sql.DB) (string, error) {
 		return "", err
 	}
 
+	//Create Audit Log Table
+	if _, err := db.Query(CreateAuditLogTable); err != nil {
+		return "", err
+	}
+
+	//Create Status History Table
+	if _, err := db.Query(CreateStatusHistoryTable); err != nil {
+		return "", err
+	}
+
+	//Create Inactive Team Table
+	if _, err := db.Query(CreateInactiveTeamTable); err != nil {
+		return "", err
+	}
+
 	return OK, nil
 }
+
+// missingTables returns tables which are not created yet
+func missingTables(db *sql.DB) ([]string, error) {
+	var missing []string
+	for _, t := range tables {
+		var exists bool
+		if err := db.QueryRow(QueryTableExists, t).Scan(&exists); err != nil {
+			return nil, err
+		}
+		if !exists {
+			missing = append(missing, t)
+		}
+	}
+	return missing, nil
+}
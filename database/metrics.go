@@ -0,0 +1,93 @@
+package database
+
+import (
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const metricsNamespace = "haaukins_store"
+
+var (
+	lockWaitSeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "lock_wait_seconds",
+		Help:      "Time spent waiting for the store lock.",
+		Buckets:   []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1, 5},
+	})
+
+	eventsDesc = prometheus.NewDesc(metricsNamespace+"_events", "Number of events by status.", []string{"status"}, nil)
+	teamsDesc  = prometheus.NewDesc(metricsNamespace+"_teams", "Total number of teams.", nil, nil)
+
+	statusNames = map[int32]string{
+		int32(Running):   "running",
+		int32(Suspended): "suspended",
+		int32(Booked):    "booked",
+		int32(Closed):    "closed",
+	}
+)
+
+// lock acquires store lock and records how long it waited for it
+func (s *store) lock() {
+	start := time.Now()
+	s.m.Lock()
+	lockWaitSeconds.Observe(time.Since(start).Seconds())
+}
+
+// Collectors returns metrics of the store, which are connection pool statistics,
+// time spent waiting for store lock and number of events and teams
+func (s *store) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		collectors.NewDBStatsCollector(s.db, metricsNamespace),
+		lockWaitSeconds,
+		&domainCollector{db: s.db},
+	}
+}
+
+// domainCollector queries number of events and teams on every scrape
+type domainCollector struct {
+	db *sql.DB
+}
+
+func (c *domainCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- eventsDesc
+	ch <- teamsDesc
+}
+
+func (c *domainCollector) Collect(ch chan<- prometheus.Metric) {
+	counts := map[string]float64{}
+	for _, name := range statusNames {
+		counts[name] = 0
+	}
+
+	rows, err := c.db.Query(QueryEventCountByStatus)
+	if err != nil {
+		log.Printf("ERR: Error collecting event metrics: %s", err.Error())
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var status int32
+		var count float64
+		if err := rows.Scan(&status, &count); err != nil {
+			log.Printf("ERR: Error collecting event metrics: %s", err.Error())
+			return
+		}
+		if name, ok := statusNames[status]; ok {
+			counts[name] = count
+		}
+	}
+	for name, count := range counts {
+		ch <- prometheus.MustNewConstMetric(eventsDesc, prometheus.GaugeValue, count, name)
+	}
+
+	var teams float64
+	if err := c.db.QueryRow(QueryTotalTeamCount).Scan(&teams); err != nil {
+		log.Printf("ERR: Error collecting team metrics: %s", err.Error())
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(teamsDesc, prometheus.GaugeValue, teams)
+}
@@ -29,6 +29,35 @@ var (
 		"last_access timestamp, " +
 		"solved_challenges text);"
 
+	CreateAuditLogTable = "CREATE TABLE IF NOT EXISTS audit_log(" +
+		"id serial primary key, " +
+		"created_at timestamp, " +
+		"method varchar (100), " +
+		"caller varchar (150), " +
+		"event_tag varchar (50), " +
+		"team_id varchar (50), " +
+		"request text, " +
+		"code varchar (50), " +
+		"error_message text);"
+
+	CreateStatusHistoryTable = "CREATE TABLE IF NOT EXISTS event_status_history(" +
+		"id serial primary key, " +
+		"event_id integer, " +
+		"event_tag varchar (50), " +
+		"status integer, " +
+		"previous_status integer, " +
+		"changed_at timestamp, " +
+		"source varchar (50), " +
+		"reason text);"
+
+	// CreateInactiveTeamTable holds teams flagged by the inactive teams policy,
+	// a flag is for the last access it was set on, so it is stale once the team accesses again
+	CreateInactiveTeamTable = "CREATE TABLE IF NOT EXISTS inactive_team(" +
+		"team_id integer primary key, " +
+		"event_tag varchar (50), " +
+		"last_access timestamp, " +
+		"flagged_at timestamp);"
+
 	AddTeamQuery = "INSERT INTO team (tag, event_id, email, name, password, created_at, last_access, solved_challenges)" +
 		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
 
@@ -38,7 +67,6 @@ var (
 		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10,$11,$12,$13,$14)"
 
 	UpdateCloseEvent            = "UPDATE event SET tag = $2, finished_at = $3 WHERE tag = $1"
-	UpdateEventStatus           = "UPDATE event SET status = $2 WHERE tag = $1 "
 	UpdateExercises             = "UPDATE event SET exercises = (SELECT (SELECT exercises FROM event WHERE id = $1) || $2) WHERE id=$1"
 	UpdateEventLastaccessedDate = "UPDATE team SET last_access = $2 WHERE tag = $1"
 	UpdateTeamSolvedChl         = "UPDATE team SET solved_challenges = $2 WHERE tag = $1"
@@ -50,16 +78,186 @@ var (
 	// finished_at '0001-01-01 00:00:00 means event does not finished yet '
 	QueryEventId    = "SELECT id FROM event WHERE tag=$1 and finished_at = date('0001-01-01 00:00:00'); "
 	QueryEventTeams = "SELECT * FROM team WHERE event_id=$1"
-	QueryTeamCount  = "SELECT count(team.id) FROM team WHERE team.event_id=$1"
 
-	QueryEventStatus           = "SELECT status FROM event WHERE tag=$1"
-	QueryAllEventsExceptClosed = "SELECT * FROM event WHERE status!=3"
-	QueryEventsByStatus        = "SELECT * FROM event WHERE status=$1"
-	QueryEventByUser           = "SELECT * FROM event WHERE status!=$1 and createdby=$2"
-	QueryIsEventExist          = "SELECT EXISTS (select tag from event where tag=$1 and status!=$2)"
-	// finished_at '0001-01-01 00:00:00 means event does not finished yet '
-	EarliestDate = "SELECT started_at FROM event WHERE started_at=(SELECT MIN(started_at) FROM event) and finished_at = date('0001-01-01 00:00:00');"
-	LatestDate   = "SELECT finish_expected FROM event WHERE finish_expected =(SELECT max(finish_expected) FROM event) and finished_at = date('0001-01-01 00:00:00');"
+	QueryEventCountByStatus = "SELECT status, count(id) FROM event GROUP BY status"
+	QueryTotalTeamCount     = "SELECT count(id) FROM team"
+
+	QueryEventStatus    = "SELECT status FROM event WHERE tag=$1"
+	QueryEventsByStatus = "SELECT * FROM event WHERE status=$1"
+	QueryEventByUser    = "SELECT * FROM event WHERE status!=$1 and createdby=$2"
+	QueryIsEventExist   = "SELECT EXISTS (select tag from event where tag=$1 and status!=$2)"
+
+	AddAuditLogQuery = "INSERT INTO audit_log (created_at, method, caller, event_tag, team_id, request, code, error_message)" +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
+	// empty caller or event tag means no filter on them
+	QueryAuditLog = "SELECT created_at, method, caller, event_tag, team_id, request, code, error_message FROM audit_log " +
+		"WHERE created_at >= $1 and created_at <= $2 and ($3::text = '' or caller = $3::text) and ($4::text = '' or event_tag = $4::text) " +
+		"ORDER BY created_at DESC LIMIT $5"
+
+	QueryTableExists = "SELECT to_regclass($1) IS NOT NULL"
+
+	// SetEventStatusQuery updates status and records the change into status history
+	// $1 tag, $2 status, $3 time of the change, $4 source
+	SetEventStatusQuery = "WITH old AS (SELECT id, status FROM event WHERE tag = $1 FOR UPDATE), " +
+		"updated AS (UPDATE event e SET status = $2 FROM old WHERE e.id = old.id RETURNING e.id, e.tag, old.status AS previous) " +
+		"INSERT INTO event_status_history (event_id, event_tag, status, previous_status, changed_at, source, reason) " +
+		"SELECT id, tag, $2, previous, $3, $4, '' FROM updated WHERE previous IS DISTINCT FROM $2"
+
+	// StartBookedEvents sets booked events whose start time is reached as running
+	// $1 now, $2 source, $3 reason
+	StartBookedEvents = "WITH started AS (UPDATE event SET status = 0 " +
+		"WHERE status = 2 and started_at > '0001-01-01' and started_at <= $1 RETURNING id, tag) " +
+		"INSERT INTO event_status_history (event_id, event_tag, status, previous_status, changed_at, source, reason) " +
+		"SELECT id, tag, 0, 2, $1, $2, $3 FROM started RETURNING event_tag"
+
+	// CloseExpiredEvents closes running and suspended events which are expected to finish before $2,
+	// like UpdateCloseEvent they are renamed to <tag>-<unix time>, so the tag could be reused.
+	// It returns the previous tags of the closed events
+	// $1 now, $2 expected finish, $3 source, $4 reason
+	CloseExpiredEvents = "WITH expired AS (SELECT id, tag, status FROM event " +
+		"WHERE status IN (0, 1) and finish_expected > '0001-01-01' and finish_expected <= $2 " +
+		"and finished_at = date('0001-01-01 00:00:00') FOR UPDATE), " +
+		"closed AS (UPDATE event e SET status = 3, finished_at = $1::timestamp, " +
+		"tag = expired.tag || '-' || extract(epoch FROM $1::timestamp)::bigint FROM expired WHERE e.id = expired.id " +
+		"RETURNING e.id, e.tag, expired.tag AS previous_tag, expired.status AS previous), " +
+		"history AS (INSERT INTO event_status_history (event_id, event_tag, status, previous_status, changed_at, source, reason) " +
+		"SELECT id, tag, 3, previous, $1::timestamp, $3, $4 FROM closed) " +
+		"SELECT previous_tag FROM closed"
+
+	// MarkExpiredEvents records running and suspended events which are expected to finish before $2
+	// as due for close into status history once, their status is not changed
+	// $1 now, $2 expected finish, $3 source, $4 reason
+	MarkExpiredEvents = "INSERT INTO event_status_history (event_id, event_tag, status, previous_status, changed_at, source, reason) " +
+		"SELECT e.id, e.tag, e.status, e.status, $1, $3, $4 FROM event e " +
+		"WHERE e.status IN (0, 1) and e.finish_expected > '0001-01-01' and e.finish_expected <= $2 " +
+		"and e.finished_at = date('0001-01-01 00:00:00') " +
+		"and NOT EXISTS (SELECT 1 FROM event_status_history h WHERE h.event_id = e.id and h.reason = $4) " +
+		"RETURNING event_tag"
+
+	// QueryStatusHistory returns status changes of the event, including the ones before its tag changed
+	QueryStatusHistory = "SELECT h.status, h.previous_status, h.changed_at, h.source, h.reason " +
+		"FROM event_status_history h JOIN event e ON e.id = h.event_id WHERE e.tag = $1 " +
+		"ORDER BY h.changed_at, h.id"
+
+	// QueryLatestEventByTag returns the most recent event with the tag, tags of closed events could be reused
+	QueryLatestEventByTag = "SELECT * FROM event WHERE tag=$1 ORDER BY id DESC LIMIT 1"
+
+	QueryEventStatusHistory = "SELECT status, previous_status, changed_at, source, reason " +
+		"FROM event_status_history WHERE event_id = $1 ORDER BY changed_at, id"
+
+	ImportEventQuery = "INSERT INTO event (tag, name, available, capacity, frontends, status, exercises, started_at, finish_expected, finished_at, createdby, onlyvpn, secretKey, disabledExercises)" +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING id"
+	ImportTeamQuery = "INSERT INTO team (tag, event_id, email, name, password, created_at, last_access, solved_challenges)" +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
+	ImportStatusHistoryQuery = "INSERT INTO event_status_history (event_id, event_tag, status, previous_status, changed_at, source, reason) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	// QueryExistingTeamTags returns which of the tags in $1 are used by teams, team tags are unique in the store
+	QueryExistingTeamTags = "SELECT tag FROM team WHERE tag = ANY($1)"
+
+	QueryAllEvents   = "SELECT * FROM event ORDER BY id"
+	QueryAllAuditLog = "SELECT created_at, method, caller, event_tag, team_id, request, code, error_message FROM audit_log ORDER BY id"
+	QueryStoreEmpty  = "SELECT NOT EXISTS (SELECT 1 FROM event) and NOT EXISTS (SELECT 1 FROM team) " +
+		"and NOT EXISTS (SELECT 1 FROM event_status_history) and NOT EXISTS (SELECT 1 FROM audit_log)"
+
+	// QueryBookingLock takes transaction level lock named $1, waiting until other transactions release it
+	QueryBookingLock = "SELECT pg_advisory_xact_lock(hashtext($1))"
+
+	// QueryTryAdvisoryLock takes session level lock named $1 if no other session holds it
+	QueryTryAdvisoryLock = "SELECT pg_try_advisory_lock(hashtext($1))"
+
+	// QueryCostTimeline sums available VMs and teams of every event which is not closed
+	// for each day it runs, events without start or expected finish time,
+	// or which are expected to finish before they start, are not counted
+	QueryCostTimeline = "WITH events AS (" +
+		"SELECT date_trunc('day', e.started_at) AS first_day, date_trunc('day', e.finish_expected) AS last_day, " +
+		"COALESCE(e.available, 0) + count(t.id) AS vms " +
+		"FROM event e LEFT JOIN team t ON t.event_id = e.id " +
+		"WHERE e.status != 3 and e.started_at > '0001-01-01' and e.finish_expected >= e.started_at " +
+		"GROUP BY e.id) " +
+		"SELECT d.day, COALESCE(sum(e.vms), 0)::integer " +
+		"FROM (SELECT min(first_day) AS first_day, max(last_day) AS last_day FROM events) b " +
+		"CROSS JOIN generate_series(b.first_day, b.last_day, interval '1 day') AS d(day) " +
+		"LEFT JOIN events e ON d.day BETWEEN e.first_day AND e.last_day " +
+		"GROUP BY d.day ORDER BY d.day"
+
+	// QueryProjectedLoad sums VMs of events which are not closed for each day between $1 and $2,
+	// events are expected to reach their capacity, so the larger of capacity
+	// and available VMs and teams is counted
+	QueryProjectedLoad = "WITH events AS (" +
+		"SELECT date_trunc('day', e.started_at) AS first_day, date_trunc('day', e.finish_expected) AS last_day, " +
+		"GREATEST(COALESCE(e.capacity, 0), COALESCE(e.available, 0) + count(t.id)) AS vms " +
+		"FROM event e LEFT JOIN team t ON t.event_id = e.id " +
+		"WHERE e.status != 3 and e.started_at > '0001-01-01' and e.finish_expected >= e.started_at " +
+		"GROUP BY e.id) " +
+		"SELECT d.day, COALESCE(sum(e.vms), 0)::integer " +
+		"FROM generate_series(date_trunc('day', $1::timestamp), date_trunc('day', $2::timestamp), interval '1 day') AS d(day) " +
+		"LEFT JOIN events e ON d.day BETWEEN e.first_day AND e.last_day " +
+		"GROUP BY d.day ORDER BY d.day"
+
+	// usageEvents lists VMs of events with their first and last buckets, it is formatted with
+	// granularity and group expression. Events are counted until they are finished, or expected
+	// to finish when they are not. $1 filters status, every status except closed when it is empty
+	usageEvents = "WITH events AS (" +
+		"SELECT %[2]s AS grp, date_trunc('%[1]s', e.started_at) AS first_bucket, " +
+		"date_trunc('%[1]s', CASE WHEN e.finished_at > '0001-01-01' THEN e.finished_at ELSE e.finish_expected END) AS last_bucket, " +
+		"COALESCE(e.available, 0) + count(t.id) AS vms " +
+		"FROM event e LEFT JOIN team t ON t.event_id = e.id " +
+		"WHERE e.started_at > '0001-01-01' and e.finish_expected >= e.started_at " +
+		"and (cardinality($1::integer[]) = 0 and e.status != 3 or e.status = ANY($1::integer[])) " +
+		"GROUP BY e.id) "
+
+	// QueryUsageBounds returns the first and last buckets of events and number of their groups,
+	// so size of the time series is known before it is generated
+	QueryUsageBounds = usageEvents +
+		"SELECT min(first_bucket), max(last_bucket), count(DISTINCT grp) FROM events"
+
+	// QueryUsageTimeSeries returns VMs of every group in every bucket between $2 and $3,
+	// it is formatted like usageEvents and with the expression which selects groups
+	QueryUsageTimeSeries = usageEvents + ", groups AS (%[3]s) " +
+		"SELECT b.bucket, g.grp, COALESCE(sum(e.vms), 0)::integer " +
+		"FROM generate_series($2::timestamp, $3::timestamp, interval '1 %[1]s') AS b(bucket) " +
+		"CROSS JOIN groups g " +
+		"LEFT JOIN events e ON e.grp = g.grp AND b.bucket BETWEEN e.first_bucket AND e.last_bucket " +
+		"GROUP BY b.bucket, g.grp ORDER BY b.bucket, g.grp"
+
+	// QueryInactiveTeams returns teams of the most recent event with tag $1 which last accessed before $2,
+	// with the time they were flagged when their flag is not stale
+	QueryInactiveTeams = "SELECT e.tag, t.tag, t.name, t.last_access, i.flagged_at " +
+		"FROM team t JOIN event e ON e.id = t.event_id " +
+		"LEFT JOIN inactive_team i ON i.team_id = t.id and i.last_access = t.last_access " +
+		"WHERE e.id = (SELECT id FROM event WHERE tag=$1 ORDER BY id DESC LIMIT 1) and t.last_access < $2 " +
+		"ORDER BY t.last_access, t.tag"
+
+	// ClearInactiveTeams removes flags of teams which accessed again, were deleted or whose event is not running
+	ClearInactiveTeams = "DELETE FROM inactive_team i WHERE NOT EXISTS (" +
+		"SELECT 1 FROM team t JOIN event e ON e.id = t.event_id " +
+		"WHERE t.id = i.team_id and t.last_access = i.last_access and e.status = 0)"
+
+	// FlagInactiveTeams flags teams of running events which last accessed before $2 at $1,
+	// it returns only the teams which were not flagged already
+	FlagInactiveTeams = "WITH flagged AS (" +
+		"INSERT INTO inactive_team (team_id, event_tag, last_access, flagged_at) " +
+		"SELECT t.id, e.tag, t.last_access, $1 FROM team t JOIN event e ON e.id = t.event_id " +
+		"WHERE e.status = 0 and t.last_access < $2 " +
+		"ON CONFLICT (team_id) DO NOTHING RETURNING team_id, event_tag, last_access, flagged_at) " +
+		"SELECT f.event_tag, t.tag, t.name, f.last_access, f.flagged_at " +
+		"FROM flagged f JOIN team t ON t.id = f.team_id ORDER BY f.event_tag, f.last_access, t.tag"
+
+	// EraseTeamData anonymizes teams whose email is $1 in every event with name $2,
+	// solves and access times are kept for statistics
+	EraseTeamData = "UPDATE team t SET email = '', name = $2, password = '' FROM event e " +
+		"WHERE e.id = t.event_id and t.email != '' and lower(t.email) = lower($1) RETURNING e.tag, t.tag"
+
+	// AnonymizeFinishedTeams anonymizes teams of events which finished before $1 with name $2,
+	// teams which are already anonymized are left out
+	AnonymizeFinishedTeams = "UPDATE team t SET email = '', name = $2, password = '' FROM event e " +
+		"WHERE e.id = t.event_id and e.finished_at > '0001-01-01' and e.finished_at <= $1 " +
+		"and (t.email != '' or t.name != $2 or t.password != '') RETURNING e.tag, t.tag"
+
+	// EraseAuditRequests removes requests of AddTeam entries of teams in $1 from audit log,
+	// since they include email and name of the team
+	EraseAuditRequests = "UPDATE audit_log SET request = '' WHERE method = 'AddTeam' and team_id = ANY($1)"
+
 	// DropEvent is used in dropping booked events
 	DropEvent = "DELETE FROM event WHERE tag=$1 and status=$2"
 )
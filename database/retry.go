@@ -0,0 +1,280 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	"github.com/lib/pq"
+)
+
+const (
+	defaultRetryInitialInterval = 500 * time.Millisecond
+	defaultRetryMaxInterval     = 30 * time.Second
+	defaultStartupTimeout       = time.Minute
+	defaultReadAttempts         = 3
+	connectTimeout              = 10 * time.Second
+)
+
+var StoreClosedErr = errors.New("store is closed")
+
+// transientCodes are Postgres errors which are expected to go away by retrying,
+// connection exceptions (class 08) are checked separately
+var transientCodes = map[pq.ErrorCode]bool{
+	"53300": true, // too_many_connections
+	"57P01": true, // admin_shutdown
+	"57P02": true, // crash_shutdown
+	"57P03": true, // cannot_connect_now
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+}
+
+type retryPolicy struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	startupTimeout  time.Duration
+	readAttempts    int
+}
+
+func newRetryPolicy(conf *model.Config) retryPolicy {
+	p := retryPolicy{
+		initialInterval: conf.DB.Retry.InitialInterval,
+		maxInterval:     conf.DB.Retry.MaxInterval,
+		startupTimeout:  conf.DB.Retry.StartupTimeout,
+		readAttempts:    conf.DB.Retry.ReadAttempts,
+	}
+	if p.initialInterval <= 0 {
+		p.initialInterval = defaultRetryInitialInterval
+	}
+	if p.maxInterval <= 0 {
+		p.maxInterval = defaultRetryMaxInterval
+	}
+	if p.startupTimeout <= 0 {
+		p.startupTimeout = defaultStartupTimeout
+	}
+	if p.readAttempts <= 0 {
+		p.readAttempts = defaultReadAttempts
+	}
+	return p
+}
+
+// backoff returns intervals between attempts, each one is
+// double of the previous one until max interval is reached
+func (p retryPolicy) backoff() func() time.Duration {
+	next := p.initialInterval
+	return func() time.Duration {
+		d := next
+		if next *= 2; next > p.maxInterval {
+			next = p.maxInterval
+		}
+		return d
+	}
+}
+
+// connect pings the database and creates tables, it is retried with backoff
+// until it succeeds, timeout passes or store is closed, zero timeout never passes
+func (s *store) connect(p retryPolicy, timeout time.Duration) error {
+	start := time.Now()
+	wait := p.backoff()
+	for {
+		err := s.initialize()
+		if err == nil {
+			log.Printf("Connected to database")
+			return nil
+		}
+
+		d := wait()
+		if timeout > 0 && time.Since(start)+d > timeout {
+			return err
+		}
+		log.Printf("ERR: Error connecting to database, retrying in %s: %s", d, err.Error())
+		select {
+		case <-s.closed:
+			return StoreClosedErr
+		case <-time.After(d):
+		}
+	}
+}
+
+// initialize does not hold the store lock, so calls are not blocked by an unreachable database,
+// ping is given up after connectTimeout when connecting hangs
+func (s *store) initialize() error {
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+	defer cancel()
+	if err := s.db.PingContext(ctx); err != nil {
+		return err
+	}
+	return InitTables(s.db)
+}
+
+// isTransient reports whether error is caused by a lost or refused connection,
+// or by a temporary condition of the database
+func isTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code.Class() == "08" || transientCodes[pqErr.Code]
+	}
+	return false
+}
+
+// retryStore retries idempotent reads of the store on transient errors,
+// backoff happens outside of the store lock, so other calls are not blocked
+type retryStore struct {
+	Store
+	policy retryPolicy
+}
+
+func (s *retryStore) retry(read func() error) error {
+	wait := s.policy.backoff()
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = read(); !isTransient(err) || attempt >= s.policy.readAttempts {
+			return err
+		}
+		d := wait()
+		log.Printf("ERR: Transient database error, retrying in %s: %s", d, err.Error())
+		time.Sleep(d)
+	}
+}
+
+func (s *retryStore) GetEvents(in *pb.GetEventRequest) ([]model.Event, error) {
+	var events []model.Event
+	err := s.retry(func() (err error) {
+		events, err = s.Store.GetEvents(in)
+		return err
+	})
+	return events, err
+}
+
+func (s *retryStore) GetEventByUser(in *pb.GetEventByUserReq) ([]model.Event, error) {
+	var events []model.Event
+	err := s.retry(func() (err error) {
+		events, err = s.Store.GetEventByUser(in)
+		return err
+	})
+	return events, err
+}
+
+func (s *retryStore) GetTeams(tag string) ([]model.Team, error) {
+	var teams []model.Team
+	err := s.retry(func() (err error) {
+		teams, err = s.Store.GetTeams(tag)
+		return err
+	})
+	return teams, err
+}
+
+func (s *retryStore) IsEventExists(in *pb.GetEventByTagReq) (bool, error) {
+	var exists bool
+	err := s.retry(func() (err error) {
+		exists, err = s.Store.IsEventExists(in)
+		return err
+	})
+	return exists, err
+}
+
+func (s *retryStore) GetCostsInTime() (map[string]int32, error) {
+	var costs map[string]int32
+	err := s.retry(func() (err error) {
+		costs, err = s.Store.GetCostsInTime()
+		return err
+	})
+	return costs, err
+}
+
+func (s *retryStore) GetUsageTimeSeries(in *pb.GetUsageTimeSeriesRequest) ([]model.UsagePoint, error) {
+	var points []model.UsagePoint
+	err := s.retry(func() (err error) {
+		points, err = s.Store.GetUsageTimeSeries(in)
+		return err
+	})
+	return points, err
+}
+
+func (s *retryStore) GetProjectedLoad(from, to time.Time) ([]model.UsagePoint, error) {
+	var points []model.UsagePoint
+	err := s.retry(func() (err error) {
+		points, err = s.Store.GetProjectedLoad(from, to)
+		return err
+	})
+	return points, err
+}
+
+func (s *retryStore) GetEventStatus(in *pb.GetEventStatusRequest) (int32, error) {
+	var status int32
+	err := s.retry(func() (err error) {
+		status, err = s.Store.GetEventStatus(in)
+		return err
+	})
+	return status, err
+}
+
+func (s *retryStore) GetEventID(in *pb.GetEventIDReq) (int32, error) {
+	var id int32
+	err := s.retry(func() (err error) {
+		id, err = s.Store.GetEventID(in)
+		return err
+	})
+	return id, err
+}
+
+func (s *retryStore) QueryAuditLog(in *pb.QueryAuditLogRequest) ([]model.AuditEntry, error) {
+	var entries []model.AuditEntry
+	err := s.retry(func() (err error) {
+		entries, err = s.Store.QueryAuditLog(in)
+		return err
+	})
+	return entries, err
+}
+
+func (s *retryStore) GetStatusHistory(tag string) ([]model.StatusChange, error) {
+	var history []model.StatusChange
+	err := s.retry(func() (err error) {
+		history, err = s.Store.GetStatusHistory(tag)
+		return err
+	})
+	return history, err
+}
+
+func (s *retryStore) GetInactiveTeams(tag string, before time.Time) ([]model.InactiveTeam, error) {
+	var teams []model.InactiveTeam
+	err := s.retry(func() (err error) {
+		teams, err = s.Store.GetInactiveTeams(tag, before)
+		return err
+	})
+	return teams, err
+}
+
+func (s *retryStore) ExportEvent(tag string) (model.EventArchive, error) {
+	var archive model.EventArchive
+	err := s.retry(func() (err error) {
+		archive, err = s.Store.ExportEvent(tag)
+		return err
+	})
+	return archive, err
+}
+
+func (s *retryStore) Backup() (model.Backup, error) {
+	var backup model.Backup
+	err := s.retry(func() (err error) {
+		backup, err = s.Store.Backup()
+		return err
+	})
+	return backup, err
+}
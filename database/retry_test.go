@@ -0,0 +1,129 @@
+package database
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	"github.com/lib/pq"
+)
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "No error", err: nil, want: false},
+		{name: "Bad connection", err: driver.ErrBadConn, want: true},
+		{name: "Connection refused", err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, want: true},
+		{name: "Wrapped connection exception", err: fmt.Errorf("query events err %w", &pq.Error{Code: "08006"}), want: true},
+		{name: "Admin shutdown", err: &pq.Error{Code: "57P01"}, want: true},
+		{name: "Unique violation", err: &pq.Error{Code: "23505"}, want: false},
+		{name: "No rows", err: sql.ErrNoRows, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTransient(tt.err); got != tt.want {
+				t.Errorf("isTransient() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	wait := retryPolicy{initialInterval: time.Second, maxInterval: 5 * time.Second}.backoff()
+	for _, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := wait(); got != want {
+			t.Errorf("backoff() = %s, want %s", got, want)
+		}
+	}
+}
+
+// flakyStore fails reads with given errors before succeeding
+type flakyStore struct {
+	Store
+	errs  []error
+	calls int
+}
+
+func (s *flakyStore) GetEvents(*pb.GetEventRequest) ([]model.Event, error) {
+	s.calls++
+	if len(s.errs) > 0 {
+		err := s.errs[0]
+		s.errs = s.errs[1:]
+		return nil, err
+	}
+	return []model.Event{{Tag: "test"}}, nil
+}
+
+func TestRetryStore(t *testing.T) {
+	policy := retryPolicy{initialInterval: time.Millisecond, maxInterval: time.Millisecond, readAttempts: 3}
+
+	tests := []struct {
+		name  string
+		errs  []error
+		calls int
+		err   bool
+	}{
+		{name: "Recovered", errs: []error{driver.ErrBadConn, &pq.Error{Code: "57P03"}}, calls: 3},
+		{name: "Attempts exhausted", errs: []error{driver.ErrBadConn, driver.ErrBadConn, driver.ErrBadConn}, calls: 3, err: true},
+		{name: "Permanent error", errs: []error{&pq.Error{Code: "42P01"}}, calls: 1, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flaky := &flakyStore{errs: tt.errs}
+			s := &retryStore{Store: flaky, policy: policy}
+			events, err := s.GetEvents(&pb.GetEventRequest{})
+			if (err != nil) != tt.err {
+				t.Fatalf("GetEvents() error = %v, want error %v", err, tt.err)
+			}
+			if !tt.err && len(events) != 1 {
+				t.Errorf("GetEvents() returned %d events, want 1", len(events))
+			}
+			if flaky.calls != tt.calls {
+				t.Errorf("GetEvents() called store %d times, want %d", flaky.calls, tt.calls)
+			}
+		})
+	}
+}
+
+func TestDegradedStart(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := lis.Addr().(*net.TCPAddr).Port
+	lis.Close()
+
+	var conf model.Config
+	conf.DB.Host = "127.0.0.1"
+	conf.DB.Port = uint(port)
+	conf.DB.User = "postgres"
+	conf.DB.Pass = "postgres"
+	conf.DB.Name = "haaukins"
+	conf.DB.Retry.InitialInterval = 10 * time.Millisecond
+	conf.DB.Retry.StartupTimeout = 50 * time.Millisecond
+
+	if _, err := NewStore(&conf); err == nil {
+		t.Fatalf("expected error when database is not reachable")
+	}
+
+	conf.DB.Retry.DegradedStart = true
+	s, err := NewStore(&conf)
+	if err != nil {
+		t.Fatalf("expected store in degraded mode, but received: %s", err)
+	}
+	if err := s.Ping(); err == nil {
+		t.Fatalf("expected ping to fail until database is reachable")
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("expected no error on close, but received: %s", err)
+	}
+}
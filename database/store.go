@@ -1,11 +1,13 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -13,9 +15,24 @@ import (
 	"github.com/aau-network-security/haaukins-store/model"
 	pb "github.com/aau-network-security/haaukins-store/proto"
 	_ "github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
-const handleNullConversionError = "converting NULL to string is unsupported"
+const (
+	handleNullConversionError = "converting NULL to string is unsupported"
+
+	defaultAuditEntries = 100
+	maxAuditEntries     = 1000
+
+	defaultGranularity = "day"
+	maxUsagePoints     = 10000
+
+	// bookingLock is held while a booking is checked against the capacity budget and added
+	bookingLock = "haaukins-store-bookings"
+
+	defaultSSLMode      = "disable"
+	defaultMaxIdleConns = 2
+)
 
 var (
 	TimeFormat = "2006-01-02 15:04:05"
@@ -30,13 +47,26 @@ var (
 
 type State int32
 
+// solvedChallenge is an entry of solved_challenges column of a team, which is a JSON list
+type solvedChallenge struct {
+	Tag         string `json:"tag"`
+	CompletedAt string `json:"completed-at"`
+}
+
 type store struct {
-	m  sync.Mutex
-	db *sql.DB
+	m      sync.Mutex
+	db     *sql.DB
+	closed chan struct{} // closed when store is closed, stops reconnecting
+	once   sync.Once
+
+	leaderM sync.Mutex
+	session *sql.Conn       // connection holding leader locks, nil when none is held
+	held    map[string]bool // names of the leader locks held by session
 }
 
 type Store interface {
 	AddEvent(*pb.AddEventRequest) (string, error)
+	AddBooking(in *pb.AddEventRequest, fits func([]model.UsagePoint) error) (string, error)
 	AddTeam(*pb.AddTeamRequest) (string, error)
 	GetEvents(*pb.GetEventRequest) ([]model.Event, error)
 	GetEventByUser(*pb.GetEventByUserReq) ([]model.Event, error)
@@ -44,6 +74,8 @@ type Store interface {
 	IsEventExists(*pb.GetEventByTagReq) (bool, error)
 	DropEvent(req *pb.DropEventReq) (bool, error)
 	GetCostsInTime() (map[string]int32, error)
+	GetUsageTimeSeries(*pb.GetUsageTimeSeriesRequest) ([]model.UsagePoint, error)
+	GetProjectedLoad(from, to time.Time) ([]model.UsagePoint, error)
 	GetEventStatus(*pb.GetEventStatusRequest) (int32, error)
 	SetEventStatus(*pb.SetEventStatusRequest) (int32, error)
 	UpdateTeamSolvedChallenge(*pb.UpdateTeamSolvedChallengeRequest) (string, error)
@@ -53,30 +85,50 @@ type Store interface {
 	UpdateExercises(req *pb.UpdateExerciseRequest) (string, error)
 	UpdateCloseEvent(*pb.UpdateEventRequest) (string, error)
 	DelTeam(request *pb.DelTeamRequest) (string, error)
+	AddAuditEntry(model.AuditEntry) error
+	QueryAuditLog(*pb.QueryAuditLogRequest) ([]model.AuditEntry, error)
+	GetStatusHistory(tag string) ([]model.StatusChange, error)
+	ExportEvent(tag string) (model.EventArchive, error)
+	ImportEvent(model.EventArchive) error
+	Backup() (model.Backup, error)
+	Restore(model.Backup) error
+	StartBookedEvents(now time.Time) ([]string, error)
+	ExpireEvents(now, before time.Time, close bool) ([]string, error)
+	GetInactiveTeams(tag string, before time.Time) ([]model.InactiveTeam, error)
+	FlagInactiveTeams(now, before time.Time) ([]model.InactiveTeam, error)
+	EraseTeamData(email string, audit model.AuditEntry) ([]model.ErasedTeam, error)
+	AnonymizeTeams(finishedBefore time.Time, audit model.AuditEntry) ([]model.ErasedTeam, error)
+	Leader(ctx context.Context, name string) (bool, error)
+	Ping() error
+	Collectors() []prometheus.Collector
+	Close() error
 }
 
+// NewStore connects to the database and creates tables, connection is retried
+// with backoff until startup timeout. In degraded start, store is returned
+// even if database is not reachable and connection is retried in background,
+// meanwhile Ping fails, so the server reports that it is not serving.
 func NewStore(conf *model.Config) (Store, error) {
-	db, err := NewDBConnection(conf)
-
+	db, err := openDB(conf)
 	if err != nil {
-		log.Fatalf("failed to connect to database: %v", err)
 		return nil, err
 	}
-	err = InitTables(db)
-	if err != nil {
-		log.Printf("failed to init tables: %v", err)
-		return nil, err
+
+	policy := newRetryPolicy(conf)
+	s := &store{db: db, closed: make(chan struct{})}
+	if err := s.connect(policy, policy.startupTimeout); err != nil {
+		if !conf.DB.Retry.DegradedStart {
+			db.Close()
+			return nil, fmt.Errorf("failed to connect to database: %w", err)
+		}
+		log.Printf("ERR: Database is not available, starting in degraded mode: %s", err.Error())
+		go s.connect(policy, 0)
 	}
-	return &store{db: db}, nil
+	return &retryStore{Store: s, policy: policy}, nil
 }
 
 func NewDBConnection(conf *model.Config) (*sql.DB, error) {
-
-	psqlInfo := fmt.Sprintf("host=%s port=%d user=%s "+
-		"password=%s dbname=%s sslmode=disable",
-		conf.DB.Host, conf.DB.Port, conf.DB.User, conf.DB.Pass, conf.DB.Name)
-	db, err := sql.Open("postgres", psqlInfo)
-
+	db, err := openDB(conf)
 	if err != nil {
 		return nil, err
 	}
@@ -88,8 +140,82 @@ func NewDBConnection(conf *model.Config) (*sql.DB, error) {
 	return db, nil
 }
 
+// openDB prepares connection pool, connections are not established until they are used
+func openDB(conf *model.Config) (*sql.DB, error) {
+	db, err := sql.Open("postgres", connectionString(conf))
+
+	if err != nil {
+		return nil, err
+	}
+
+	db.SetMaxOpenConns(conf.DB.MaxOpenConns)
+	db.SetMaxIdleConns(maxIdleConns(conf))
+	db.SetConnMaxLifetime(conf.DB.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(conf.DB.ConnMaxIdleTime)
+	return db, nil
+}
+
+// connectionString returns connection string of the configured database,
+// statement timeout is sent as run-time parameter of every connection.
+// Configured connection string is used as it is, statement timeout is rejected with it.
+func connectionString(conf *model.Config) string {
+	if conf.DB.ConnectionString != "" {
+		return conf.DB.ConnectionString
+	}
+
+	sslMode := conf.DB.SSLMode
+	if sslMode == "" {
+		sslMode = defaultSSLMode
+	}
+	params := []struct{ key, value string }{
+		{"host", conf.DB.Host},
+		{"port", strconv.Itoa(int(conf.DB.Port))},
+		{"user", conf.DB.User},
+		{"password", conf.DB.Pass},
+		{"dbname", conf.DB.Name},
+		{"sslmode", sslMode},
+		{"sslrootcert", conf.DB.SSLRootCert},
+		{"sslcert", conf.DB.SSLCert},
+		{"sslkey", conf.DB.SSLKey},
+	}
+	if conf.DB.StatementTimeout > 0 {
+		params = append(params, struct{ key, value string }{"statement_timeout", strconv.FormatInt(conf.DB.StatementTimeout.Milliseconds(), 10)})
+	}
+
+	var info []string
+	for _, p := range params {
+		if p.value == "" {
+			continue
+		}
+		info = append(info, fmt.Sprintf("%s=%s", p.key, quoteParam(p.value)))
+	}
+	return strings.Join(info, " ")
+}
+
+// quoteParam quotes value of a connection parameter when it is empty
+// or contains spaces, quotes or backslashes
+func quoteParam(v string) string {
+	if v != "" && !strings.ContainsAny(v, ` '\`) {
+		return v
+	}
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(v) + "'"
+}
+
+// maxIdleConns keeps idle connections within open connections limit,
+// database/sql keeps 2 idle connections when it is not set
+func maxIdleConns(conf *model.Config) int {
+	idle := conf.DB.MaxIdleConns
+	if idle == 0 {
+		idle = defaultMaxIdleConns
+	}
+	if conf.DB.MaxOpenConns > 0 && idle > conf.DB.MaxOpenConns {
+		idle = conf.DB.MaxOpenConns
+	}
+	return idle
+}
+
 func (s *store) AddEvent(in *pb.AddEventRequest) (string, error) {
-	s.m.Lock()
+	s.lock()
 	defer s.m.Unlock()
 
 	startTime, _ := time.Parse(TimeFormat, in.StartTime)
@@ -104,8 +230,44 @@ func (s *store) AddEvent(in *pb.AddEventRequest) (string, error) {
 	return "Event correctly added!", nil
 }
 
+// AddBooking adds the event when fits accepts projected load of its days without it.
+// Bookings are serialized by a transaction level lock, so concurrent bookings,
+// also of other servers sharing the database, could not exceed the budget together.
+func (s *store) AddBooking(in *pb.AddEventRequest, fits func([]model.UsagePoint) error) (string, error) {
+	s.lock()
+	defer s.m.Unlock()
+
+	startTime, _ := time.Parse(TimeFormat, in.StartTime)
+	finishTime, _ := time.Parse(TimeFormat, in.FinishedAt)
+	expectedFinishTime, _ := time.Parse(TimeFormat, in.ExpectedFinishTime)
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(QueryBookingLock, bookingLock); err != nil {
+		return "", err
+	}
+	load, err := projectedLoad(tx, startTime, expectedFinishTime)
+	if err != nil {
+		return "", err
+	}
+	if err := fits(load); err != nil {
+		return "", err
+	}
+	if _, err := tx.Exec(AddEventQuery, in.Tag, in.Name, in.Available, in.Capacity, in.Frontends, in.Status, in.Exercises, startTime, expectedFinishTime, finishTime, in.CreatedBy, in.OnlyVPN, in.SecretKey, in.DisabledExercises); err != nil {
+		return "", err
+	}
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+	return "Event correctly added!", nil
+}
+
 func (s *store) AddTeam(in *pb.AddTeamRequest) (string, error) {
-	s.m.Lock()
+	s.lock()
 	defer s.m.Unlock()
 
 	now := time.Now()
@@ -137,7 +299,7 @@ func (s *store) UpdateExercises(req *pb.UpdateExerciseRequest) (string, error) {
 }
 
 func (s *store) DelTeam(req *pb.DelTeamRequest) (string, error) {
-	s.m.Lock()
+	s.lock()
 	defer s.m.Unlock()
 
 	var eventId int
@@ -155,7 +317,7 @@ func (s *store) DelTeam(req *pb.DelTeamRequest) (string, error) {
 func (s *store) GetEvents(in *pb.GetEventRequest) ([]model.Event, error) {
 	var rows *sql.Rows
 	var err error
-	s.m.Lock()
+	s.lock()
 	defer s.m.Unlock()
 
 	switch in.Status {
@@ -164,31 +326,31 @@ func (s *store) GetEvents(in *pb.GetEventRequest) ([]model.Event, error) {
 		// query only running events
 		rows, err = s.db.Query(QueryEventsByStatus, int32(Running))
 		if err != nil {
-			return nil, fmt.Errorf("query running events err %v", err)
+			return nil, fmt.Errorf("query running events err %w", err)
 		}
 	case int32(Suspended):
 		// query only suspended events
 		rows, err = s.db.Query(QueryEventsByStatus, int32(Suspended))
 		if err != nil {
-			return nil, fmt.Errorf("query suspended events err %v", err)
+			return nil, fmt.Errorf("query suspended events err %w", err)
 		}
 	case int32(Booked):
 		// query only booked events
 		rows, err = s.db.Query(QueryEventsByStatus, int32(Booked))
 		if err != nil {
-			return nil, fmt.Errorf("query boooked events err %v", err)
+			return nil, fmt.Errorf("query boooked events err %w", err)
 		}
 		// query only closed events
 	case int32(Closed):
 		rows, err = s.db.Query(QueryEventsByStatus, int32(Closed))
 		if err != nil {
-			return nil, fmt.Errorf("query closed events err %v", err)
+			return nil, fmt.Errorf("query closed events err %w", err)
 		}
 	default:
 		// all events
 		rows, err = s.db.Query(QueryEventTable)
 		if err != nil {
-			return nil, fmt.Errorf("query running events err %v", err)
+			return nil, fmt.Errorf("query running events err %w", err)
 		}
 	}
 
@@ -196,17 +358,17 @@ func (s *store) GetEvents(in *pb.GetEventRequest) ([]model.Event, error) {
 }
 
 func (s *store) GetEventByUser(in *pb.GetEventByUserReq) ([]model.Event, error) {
-	s.m.Lock()
+	s.lock()
 	defer s.m.Unlock()
 	rows, err := s.db.Query(QueryEventByUser, in.Status, in.User)
 	if err != nil {
-		return nil, fmt.Errorf("query suspended events err %v", err)
+		return nil, fmt.Errorf("query suspended events err %w", err)
 	}
 	return parseEvents(rows)
 }
 
 func (s *store) GetTeams(tag string) ([]model.Team, error) {
-	s.m.Lock()
+	s.lock()
 	defer s.m.Unlock()
 
 	var eventId int
@@ -218,23 +380,11 @@ func (s *store) GetTeams(tag string) ([]model.Team, error) {
 	if err != nil {
 		return nil, err
 	}
-
-	var teams []model.Team
-	for rows.Next() {
-
-		team := new(model.Team)
-		err := rows.Scan(&team.Id, &team.Tag, &team.EventId, &team.Email, &team.Name, &team.Password, &team.CreatedAt,
-			&team.LastAccess, &team.SolvedChallenges)
-		if err != nil && !strings.Contains(err.Error(), handleNullConversionError) {
-			return nil, err
-		}
-		teams = append(teams, *team)
-	}
-	return teams, nil
+	return parseTeams(rows)
 }
 
 func (s *store) GetCostsInTime() (map[string]int32, error) {
-	s.m.Lock()
+	s.lock()
 	defer s.m.Unlock()
 	m, err := calculateCost(s.db)
 	if err != nil {
@@ -243,16 +393,23 @@ func (s *store) GetCostsInTime() (map[string]int32, error) {
 	return m, nil
 }
 
-func (s *store) UpdateTeamSolvedChallenge(in *pb.UpdateTeamSolvedChallengeRequest) (string, error) {
-	s.m.Lock()
+func (s *store) GetUsageTimeSeries(in *pb.GetUsageTimeSeriesRequest) ([]model.UsagePoint, error) {
+	s.lock()
 	defer s.m.Unlock()
+	return usageTimeSeries(s.db, in)
+}
 
-	type Challenge struct {
-		Tag         string `json:"tag"`
-		CompletedAt string `json:"completed-at"`
-	}
+func (s *store) GetProjectedLoad(from, to time.Time) ([]model.UsagePoint, error) {
+	s.lock()
+	defer s.m.Unlock()
+	return projectedLoad(s.db, from, to)
+}
 
-	var solvedChallenges []Challenge
+func (s *store) UpdateTeamSolvedChallenge(in *pb.UpdateTeamSolvedChallengeRequest) (string, error) {
+	s.lock()
+	defer s.m.Unlock()
+
+	var solvedChallenges []solvedChallenge
 	var solvedChallengesDB string
 
 	if err := s.db.QueryRow(QuerySolvedChls, in.TeamId).Scan(&solvedChallengesDB); err != nil {
@@ -269,7 +426,7 @@ func (s *store) UpdateTeamSolvedChallenge(in *pb.UpdateTeamSolvedChallengeReques
 		}
 	}
 
-	solvedChallenges = append(solvedChallenges, Challenge{
+	solvedChallenges = append(solvedChallenges, solvedChallenge{
 		Tag:         in.Tag,
 		CompletedAt: in.CompletedAt,
 	})
@@ -302,7 +459,7 @@ func (s *store) GetEventID(in *pb.GetEventIDReq) (int32, error) {
 }
 
 func (s *store) UpdateTeamLastAccess(in *pb.UpdateTeamLastAccessRequest) (string, error) {
-	s.m.Lock()
+	s.lock()
 	defer s.m.Unlock()
 
 	_, err := s.db.Exec(UpdateEventLastaccessedDate, in.TeamId, in.AccessAt)
@@ -314,7 +471,7 @@ func (s *store) UpdateTeamLastAccess(in *pb.UpdateTeamLastAccessRequest) (string
 }
 
 func (s *store) UpdateCloseEvent(in *pb.UpdateEventRequest) (string, error) {
-	s.m.Lock()
+	s.lock()
 	defer s.m.Unlock()
 
 	_, err := s.db.Exec(UpdateCloseEvent, in.OldTag, in.NewTag, in.FinishedAt)
@@ -326,7 +483,7 @@ func (s *store) UpdateCloseEvent(in *pb.UpdateEventRequest) (string, error) {
 }
 
 func (s *store) GetEventStatus(in *pb.GetEventStatusRequest) (int32, error) {
-	s.m.Lock()
+	s.lock()
 	defer s.m.Unlock()
 
 	var status int32
@@ -339,9 +496,9 @@ func (s *store) GetEventStatus(in *pb.GetEventStatusRequest) (int32, error) {
 }
 
 func (s *store) SetEventStatus(in *pb.SetEventStatusRequest) (int32, error) {
-	s.m.Lock()
+	s.lock()
 	defer s.m.Unlock()
-	_, err := s.db.Exec(UpdateEventStatus, in.EventTag, in.Status)
+	_, err := s.db.Exec(SetEventStatusQuery, in.EventTag, in.Status, time.Now().UTC(), APISource)
 	if err != nil {
 		return Error, err
 	}
@@ -374,6 +531,80 @@ func (s *store) DropEvent(in *pb.DropEventReq) (bool, error) {
 
 }
 
+// Ping checks whether database is reachable and all tables are created
+func (s *store) Ping() error {
+	if err := s.db.Ping(); err != nil {
+		return err
+	}
+	missing, err := missingTables(s.db)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("pending migrations, missing tables: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Close closes database connections, it waits for running queries
+func (s *store) Close() error {
+	s.once.Do(func() {
+		if s.closed != nil {
+			close(s.closed)
+		}
+	})
+	s.releaseLeadership()
+	s.lock()
+	defer s.m.Unlock()
+	return s.db.Close()
+}
+
+// AddAuditEntry does not lock the store, audit entries are written
+// after every mutating call and should not wait for other queries
+func (s *store) AddAuditEntry(e model.AuditEntry) error {
+	_, err := s.db.Exec(AddAuditLogQuery, e.CreatedAt, e.Method, e.Caller, e.EventTag, e.TeamId, e.Request, e.Code, e.ErrorMessage)
+	return err
+}
+
+func (s *store) QueryAuditLog(in *pb.QueryAuditLogRequest) ([]model.AuditEntry, error) {
+	from := time.Time{}
+	to := time.Now().AddDate(100, 0, 0)
+	var err error
+	if in.From != "" {
+		if from, err = time.Parse(TimeFormat, in.From); err != nil {
+			return nil, fmt.Errorf("invalid from time: %v", err)
+		}
+	}
+	if in.To != "" {
+		if to, err = time.Parse(TimeFormat, in.To); err != nil {
+			return nil, fmt.Errorf("invalid to time: %v", err)
+		}
+	}
+	limit := in.Limit
+	if limit <= 0 {
+		limit = defaultAuditEntries
+	}
+	if limit > maxAuditEntries {
+		limit = maxAuditEntries
+	}
+
+	rows, err := s.db.Query(QueryAuditLog, from, to, in.Caller, in.EventTag, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query audit log err %w", err)
+	}
+	defer rows.Close()
+
+	var entries []model.AuditEntry
+	for rows.Next() {
+		var e model.AuditEntry
+		if err := rows.Scan(&e.CreatedAt, &e.Method, &e.Caller, &e.EventTag, &e.TeamId, &e.Request, &e.Code, &e.ErrorMessage); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
 func parseEvents(rows *sql.Rows) ([]model.Event, error) {
 	var events []model.Event
 	for rows.Next() {
@@ -388,6 +619,21 @@ func parseEvents(rows *sql.Rows) ([]model.Event, error) {
 	return events, nil
 }
 
+func parseTeams(rows *sql.Rows) ([]model.Team, error) {
+	var teams []model.Team
+	for rows.Next() {
+
+		team := new(model.Team)
+		err := rows.Scan(&team.Id, &team.Tag, &team.EventId, &team.Email, &team.Name, &team.Password, &team.CreatedAt,
+			&team.LastAccess, &team.SolvedChallenges)
+		if err != nil && !strings.Contains(err.Error(), handleNullConversionError) {
+			return nil, err
+		}
+		teams = append(teams, *team)
+	}
+	return teams, nil
+}
+
 //
 //func (s *store) GetEventsByStatus () ([]model.Event, error) {
 //	s.m.Lock()
@@ -2,169 +2,202 @@ package database
 
 import (
 	"database/sql"
-	"log"
-	"math"
-	"strings"
+	"fmt"
 	"time"
 
 	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	"github.com/lib/pq"
 )
 
 // calculateCost will return a map which is
 // time and number of running vms in total for
-// given time, it is like a timeSeries
+// given time, it is like a timeSeries.
+// Every day between the earliest start and the latest expected finish of
+// events which are not closed is present, days without any event are zero.
 func calculateCost(db *sql.DB) (map[string]int32, error) {
-
-	sT, err := getEarliestDate(db)
-	if err != nil {
-		log.Fatalf("Error get earliest date %v", err)
-	}
-
-	fT, err := getLastDate(db)
+	rows, err := db.Query(QueryCostTimeline)
 	if err != nil {
-		log.Fatalf("Error get latest date %v", err)
+		return nil, fmt.Errorf("query cost timeline err %w", err)
 	}
-	timeSeries := getDates(sT, fT)
+	defer rows.Close()
 
 	timeSeriesCount := make(map[string]int32)
-	for _, time := range timeSeries {
-		timeSeriesCount[time.Format(TimeFormat)] = 0
+	for rows.Next() {
+		var day time.Time
+		var vms int32
+		if err := rows.Scan(&day, &vms); err != nil {
+			return nil, fmt.Errorf("scan cost timeline err %w", err)
+		}
+		timeSeriesCount[day.Format(TimeFormat)] = vms
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read cost timeline err %w", err)
 	}
+	return timeSeriesCount, nil
+}
 
-	events := getEvents(db)
+// usageGranularities are intervals of usage time series
+var usageGranularities = map[string]bool{"hour": true, "day": true, "week": true, "month": true}
 
-	for _, e := range events {
-		sT, err := time.Parse(time.RFC3339, e.StartedAt)
-		if err != nil {
-			log.Fatalf("Error happened %v ", err)
-		}
-		fT, err := time.Parse(time.RFC3339, e.ExpectedFinishTime)
+// usageGroups are expressions of event columns which usage could be grouped by
+var usageGroups = map[string]string{
+	"":          "''::text",
+	"createdBy": "COALESCE(e.createdby, '')",
+	"event":     "e.tag",
+}
+
+// usageTimeSeries returns VMs used by events in every interval of given range,
+// when grouped, there is a point for every group in every interval
+func usageTimeSeries(db *sql.DB, in *pb.GetUsageTimeSeriesRequest) ([]model.UsagePoint, error) {
+	granularity := in.Granularity
+	if granularity == "" {
+		granularity = defaultGranularity
+	}
+	if !usageGranularities[granularity] {
+		return nil, fmt.Errorf("invalid granularity %q, use hour, day, week or month", in.Granularity)
+	}
+	group, ok := usageGroups[in.GroupBy]
+	if !ok {
+		return nil, fmt.Errorf("invalid group %q, use createdBy or event", in.GroupBy)
+	}
+	groups := "SELECT ''::text AS grp"
+	if in.GroupBy != "" {
+		groups = "SELECT DISTINCT grp FROM events"
+	}
+
+	// zero times are filled from the events
+	var from, to time.Time
+	if in.From != "" {
+		t, err := time.Parse(TimeFormat, in.From)
 		if err != nil {
-			log.Fatalf("Error happened %v ", err)
-		}
-		if isInvalidDate(sT) && isInvalidDate(fT) {
-			log.Fatalf("Invalid date formatting !!! ")
+			return nil, fmt.Errorf("invalid from time: %v", err)
 		}
-		invalidTime, err := time.Parse(TimeFormat, "0001-01-01 00:00:00")
+		from = t
+	}
+	if in.To != "" {
+		t, err := time.Parse(TimeFormat, in.To)
 		if err != nil {
-			log.Fatalf("Error happened %v ", err)
+			return nil, fmt.Errorf("invalid to time: %v", err)
 		}
-		iterateOver := getDates(sT, fT)
-		teamCount := getTeamsCount(db, int(e.Id))
-		available := int(e.Available)
-		for _, f := range iterateOver {
-			timeSeriesCount[f.Format(TimeFormat)] += int32(available) + int32(teamCount)
+		if !from.IsZero() && t.Before(from) {
+			return nil, fmt.Errorf("to time %s is before from time %s", in.To, in.From)
 		}
-		delete(timeSeriesCount, invalidTime.Format(TimeFormat))
+		to = truncateBucket(t, granularity)
 	}
-
-	return timeSeriesCount, nil
-}
-
-// getEvents will query event table
-// without any condition
-func getEvents(db *sql.DB) []model.Event {
-	rows, err := db.Query(QueryAllEventsExceptClosed)
-	if err != nil {
-
-		log.Printf("query running events err %v", err)
+	if !from.IsZero() {
+		from = truncateBucket(from, granularity)
 	}
-	var events []model.Event
-	for rows.Next() {
-		event := new(model.Event)
-		err := rows.Scan(&event.Id, &event.Tag, &event.Name, &event.Available, &event.Capacity, &event.Status, &event.Frontends,
-			&event.Exercises, &event.StartedAt, &event.ExpectedFinishTime, &event.FinishedAt, &event.CreatedBy, &event.OnlyVPN, &event.SecretKey, &event.DisabledExercises)
-		if err != nil && !strings.Contains(err.Error(), handleNullConversionError) {
-			log.Fatalf("Error on scanning query %v", err)
-		}
-		events = append(events, *event)
+	// range is checked before anything is queried, groups only make series longer
+	if !from.IsZero() && !to.IsZero() && usageBuckets(from, to, granularity) > maxUsagePoints {
+		return nil, tooManyUsagePoints()
 	}
 
-	return events
-}
+	// empty array, not NULL, selects every status except closed
+	statuses := make(pq.Int64Array, 0, len(in.Status))
+	for _, s := range in.Status {
+		statuses = append(statuses, int64(s))
+	}
 
-// getEarliestDate returns largest (finishDate) date from events table
-func getLastDate(db *sql.DB) (time.Time, error) {
-	var latestFinishTime time.Time
-	r, err := db.Query(LatestDate)
-	if err != nil {
-		log.Fatalf("Latest Date query error %v", err)
+	var first, last sql.NullTime
+	var groupCount int64
+	if err := db.QueryRow(fmt.Sprintf(QueryUsageBounds, granularity, group), statuses).Scan(&first, &last, &groupCount); err != nil {
+		return nil, fmt.Errorf("query usage bounds err %w", err)
 	}
-	latestTime := new(time.Time)
-	for r.Next() {
-		if err := r.Scan(&latestTime); err != nil {
-			return time.Time{}, err
-		}
-		latestFinishTime = *latestTime
+	if from.IsZero() {
+		from = first.Time
+	}
+	if to.IsZero() {
+		to = last.Time
+	}
+	if in.GroupBy == "" {
+		groupCount = 1
+	}
+	if from.IsZero() || to.IsZero() || to.Before(from) || groupCount == 0 {
+		return nil, nil
+	}
+	if buckets := usageBuckets(from, to, granularity); buckets > maxUsagePoints || buckets*groupCount > maxUsagePoints {
+		return nil, tooManyUsagePoints()
 	}
-	return latestFinishTime, nil
-
-}
 
-// getEarliestDate returns smallest date from events table
-func getEarliestDate(db *sql.DB) (time.Time, error) {
-	var earliestStartTime time.Time
-	r, err := db.Query(EarliestDate)
+	query := fmt.Sprintf(QueryUsageTimeSeries, granularity, group, groups)
+	rows, err := db.Query(query, statuses, from, to)
 	if err != nil {
-		log.Fatalf("Earliest Date query error %v", err)
+		return nil, fmt.Errorf("query usage time series err %w", err)
 	}
-	earliestTime := new(time.Time)
-	for r.Next() {
-		if err := r.Scan(&earliestTime); err != nil {
-			return time.Time{}, err
+	defer rows.Close()
+
+	var points []model.UsagePoint
+	for rows.Next() {
+		var p model.UsagePoint
+		if err := rows.Scan(&p.Time, &p.Group, &p.VMs); err != nil {
+			return nil, fmt.Errorf("scan usage time series err %w", err)
 		}
-		earliestStartTime = *earliestTime
+		points = append(points, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read usage time series err %w", err)
 	}
-	return earliestStartTime, nil
+	return points, nil
 }
 
-// isValidDate function is ensuring that
-// time parsing done correctly and successfully
-func isInvalidDate(t time.Time) bool {
-	if t == time.Date(0001, 01, 01, 00, 00, 00, 0000, time.UTC) {
-		//log.Println("Error in parsing; invalid date 0001-01-01 00:00:00 +0000 UTC  ")
-		return true
-	}
-	return false
+func tooManyUsagePoints() error {
+	return fmt.Errorf("time series has more than %d points, use a shorter range or larger granularity", maxUsagePoints)
 }
 
-// getTemasCount return number of team on given eventID
-func getTeamsCount(db *sql.DB, eventID int) int {
-	var count int
-	if err := db.QueryRow(QueryTeamCount, eventID).Scan(&count); err != nil {
-		log.Fatalf("Query row error postgres %v", err)
+// truncateBucket returns start of the bucket which t belongs to, like date_trunc does
+func truncateBucket(t time.Time, granularity string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch granularity {
+	case "hour":
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	case "week":
+		// weeks start on Monday
+		return day.AddDate(0, 0, -(int(t.Weekday())+6)%7)
+	case "month":
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
 	}
-	return count
+	return day
 }
 
-// daysInDates returns number of days in given two dates
-func daysInDates(sT, fT time.Time) int {
-	days := fT.Sub(sT).Hours() / 24
-	return int(math.Round(days))
+// usageBuckets returns number of buckets between starts of the first and the last buckets
+func usageBuckets(first, last time.Time, granularity string) int64 {
+	if last.Before(first) {
+		return 0
+	}
+	var size time.Duration
+	switch granularity {
+	case "hour":
+		size = time.Hour
+	case "week":
+		size = 7 * 24 * time.Hour
+	case "month":
+		return int64(last.Year()-first.Year())*12 + int64(last.Month()-first.Month()) + 1
+	default:
+		size = 24 * time.Hour
+	}
+	// Sub saturates instead of overflowing on ranges longer than Duration holds
+	return int64(last.Sub(first)/size) + 1
 }
 
-// return list of dates between given two date
-// will generate list of date
-func getDates(sT, fT time.Time) []time.Time {
-	var dates []time.Time
-
-	// zeroing hour:minute:second and nanosecond and setting zone to UTC
-	sT = zeroTime(sT)
-	fT = zeroTime(fT)
-	// calculate # of days in between dates
-	days := daysInDates(sT, fT)
-	var count int
-	for count <= days {
-		date := sT
-		sT = date.AddDate(0, 0, 1)
-		dates = append(dates, date)
-		count++
-	}
-	return dates
-}
+// projectedLoad returns VMs expected to be used by events for every day between from and to
+func projectedLoad(db querier, from, to time.Time) ([]model.UsagePoint, error) {
+	rows, err := db.Query(QueryProjectedLoad, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("query projected load err %w", err)
+	}
+	defer rows.Close()
 
-// zeroTime will set hour minute and second to zero
-// also sets time location
-func zeroTime(t time.Time) time.Time {
-	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0000, time.UTC)
+	var points []model.UsagePoint
+	for rows.Next() {
+		var p model.UsagePoint
+		if err := rows.Scan(&p.Time, &p.VMs); err != nil {
+			return nil, fmt.Errorf("scan projected load err %w", err)
+		}
+		points = append(points, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read projected load err %w", err)
+	}
+	return points, nil
 }
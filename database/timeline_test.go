@@ -3,13 +3,14 @@ package database
 import (
 	"database/sql"
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
 	"time"
 
-	_ "github.com/lib/pq"
-
 	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	_ "github.com/lib/pq"
 )
 
 type fakeEvent struct {
@@ -73,218 +74,22 @@ func cleanRecords(db *sql.DB) error {
 	if err != nil {
 		return err
 	}
-	return nil
-}
-
-// tests starts here
-
-func TestIsInvalidDate(t *testing.T) {
-
-	tests := []struct {
-		name string
-		t    time.Time
-		want bool
-	}{
-		{name: "Invalid date format", t: time.Date(0001, 01, 01, 00, 00, 00, 0000, time.UTC), want: true},
-		{name: "Valid date format", t: time.Now(), want: false},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := isInvalidDate(tt.t); got != tt.want {
-				t.Errorf("isInvalidDate() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func TestDaysInDates(t *testing.T) {
-
-	tests := []struct {
-		name string
-		sT   time.Time
-		fT   time.Time
-		want int
-	}{
-		{name: "2 Days", sT: time.Now(), fT: time.Now().AddDate(0, 0, 2), want: 2},
-		{name: "1 Day", sT: time.Now(), fT: time.Now().AddDate(0, 0, 1), want: 1},
-		{name: "20 Day", sT: time.Now(), fT: time.Now().AddDate(0, 0, 20), want: 20},
-		{name: "0 Day", sT: time.Now(), fT: time.Now().AddDate(0, 0, 0), want: 0},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := daysInDates(tt.sT, tt.fT); got != tt.want {
-				t.Errorf("daysInDates() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func TestGetDates(t *testing.T) {
-	tests := []struct {
-		name string
-		sT   time.Time
-		fT   time.Time
-		want []time.Time
-	}{
-		{name: "3 Days",
-			sT: time.Date(2020, 05, 19, 00, 00, 00, 00000, time.UTC),
-			fT: time.Date(2020, 05, 21, 00, 00, 00, 00000, time.UTC),
-			want: []time.Time{
-				time.Date(2020, 05, 19, 00, 00, 00, 00000, time.UTC),
-				time.Date(2020, 05, 20, 00, 00, 00, 00000, time.UTC),
-				time.Date(2020, 05, 21, 00, 00, 00, 00000, time.UTC)},
-		},
-		{name: "1 Days",
-			sT: time.Date(2020, 05, 19, 00, 00, 00, 00000, time.UTC),
-			fT: time.Date(2020, 05, 19, 00, 00, 00, 00000, time.UTC),
-			want: []time.Time{
-				time.Date(2020, 05, 19, 00, 00, 00, 00000, time.UTC),
-			},
-		},
-		{name: "2 Days",
-			sT: time.Date(2020, 05, 19, 00, 00, 00, 00000, time.UTC),
-			fT: time.Date(2020, 05, 20, 00, 00, 00, 00000, time.UTC),
-			want: []time.Time{
-				time.Date(2020, 05, 19, 00, 00, 00, 00000, time.UTC),
-				time.Date(2020, 05, 20, 00, 00, 00, 00000, time.UTC),
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := getDates(tt.sT, tt.fT); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("getDates() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func TestZeroTime(t *testing.T) {
-	tests := []struct {
-		name string
-		t    time.Time
-		want time.Time
-	}{
-		{name: "19 May 1919", t: time.Date(1919, 05, 19, 05, 04, 24, 4152, time.UTC),
-			want: time.Date(1919, 05, 19, 00, 00, 00, 0000, time.UTC)},
-		{name: "23 April 1920", t: time.Date(1920, 04, 23, 12, 24, 54, 1251, time.UTC),
-			want: time.Date(1920, 04, 23, 00, 00, 00, 0000, time.UTC)},
-		{name: "9  November 1938 :( ", t: time.Date(1938, 11, 9, 9, 05, 05, 0005, time.UTC),
-			want: time.Date(1938, 11, 9, 00, 00, 00, 0000, time.UTC)},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := zeroTime(tt.t); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("zeroTime() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func TestGetEvents(t *testing.T) {
-	var eventID int
-	db, err := setup()
+	_, err = db.Query("DELETE FROM event_status_history;")
 	if err != nil {
-		t.Fatalf("Setting up env error %v ", err)
-	}
-	startTime, _ := time.Parse(TimeFormat, "1919-05-19 19:19:19")
-	expectedFinishTime, _ := time.Parse(TimeFormat, "1923-04-23 09:00:00")
-
-	if err := insertFakeEvent(fakeEvent{tag: "zafer", sT: startTime, fT: expectedFinishTime}, db); err != nil {
-		t.Fatalf("Executing add event error %v", err)
-	}
-
-	if err = db.QueryRow("SELECT event.id FROM event where event.tag='zafer'").Scan(&eventID); err != nil {
-		t.Fatalf("Error on getting event id  %v", err)
-	}
-	eventIds := make(map[string]uint)
-	eventIds["zafer"] = uint(eventID)
-	events := []model.Event{model.Event{
-		Id:                 eventIds["zafer"],
-		Tag:                "zafer",
-		Name:               "",
-		Frontends:          "kali",
-		Exercises:          "ftp,sql",
-		Available:          0, // fakeEvent does not need available or capacity, hence setting it to zero is ok
-		Capacity:           0, // fakeEvent does not need available or capacity, hence setting it to zero is ok
-		Status:             1,
-		StartedAt:          startTime.Format(time.RFC3339),
-		ExpectedFinishTime: expectedFinishTime.Format(time.RFC3339),
-		FinishedAt:         time.Date(0001, 01, 01, 00, 00, 00, 0000, time.UTC).Format(time.RFC3339),
-		CreatedBy:          "tester",
-		OnlyVPN:            false,
-		SecretKey:          "",
-		DisabledExercises:  "",
-	}}
-
-	if got := getEvents(db); !reflect.DeepEqual(got, events) {
-		t.Errorf("getEvents() = %v, want %v", got, events)
+		return err
 	}
-
-}
-
-func TestGetLatestDate(t *testing.T) {
-	db, err := setup()
+	_, err = db.Query("DELETE FROM audit_log;")
 	if err != nil {
-		t.Fatalf("Setting up env error %v ", err)
-	}
-	sT, _ := time.Parse(TimeFormat, "1919-05-19 19:19:19")
-	fT, _ := time.Parse(TimeFormat, "1923-04-23 09:00:00")
-	fEvent := fakeEvent{sT: sT, fT: fT, capacity: 10, tag: "19May"}
-	if err := insertFakeEvent(fEvent, db); err != nil {
-		t.Fatalf("insertFakeEvention error on events for event %s %v", fEvent.tag, err)
-	}
-	sT, _ = time.Parse(TimeFormat, "1923-10-29 19:19:19")
-	fT, _ = time.Parse(TimeFormat, "1938-11-10 09:05:00")
-	fEvent = fakeEvent{sT: sT, fT: fT, capacity: 10, tag: "23April"}
-	if err := insertFakeEvent(fEvent, db); err != nil {
-		t.Fatalf("insertFakeEvention error on events for event %s %v", fEvent.tag, err)
+		return err
 	}
-
-	got, err := getLastDate(db)
+	_, err = db.Query("DELETE FROM inactive_team;")
 	if err != nil {
-		t.Errorf("getLastDate() error = %v", err)
-		return
-	}
-	// latest date is fT
-	// UTC is trailing that's why Local() called
-	want := fT.Local()
-	if !reflect.DeepEqual(got.Local(), want) {
-		t.Errorf("getLastDate() got = %v, want %v", got, want)
+		return err
 	}
+	return nil
 }
 
-func TestGetEarliestDate(t *testing.T) {
-	db, err := setup()
-	if err != nil {
-		t.Fatalf("Setting up env error %v ", err)
-	}
-	sT, _ := time.Parse(TimeFormat, "1919-05-19 19:19:19")
-	minST := sT
-	fT, _ := time.Parse(TimeFormat, "1923-04-23 09:00:00")
-	fEvent := fakeEvent{sT: sT, fT: fT, capacity: 10, tag: "19May"}
-	if err := insertFakeEvent(fEvent, db); err != nil {
-		t.Fatalf("insertFakeEvention error on events for event %s %v", fEvent.tag, err)
-	}
-	sT, _ = time.Parse(TimeFormat, "1923-10-29 19:19:19")
-	fT, _ = time.Parse(TimeFormat, "1938-11-10 09:05:00")
-	fEvent = fakeEvent{sT: sT, fT: fT, capacity: 10, tag: "23April"}
-	if err := insertFakeEvent(fEvent, db); err != nil {
-		t.Fatalf("insertFakeEvention error on events for event %s %v", fEvent.tag, err)
-	}
-
-	got, err := getEarliestDate(db)
-	if err != nil {
-		t.Errorf("getLastDate() error = %v", err)
-		return
-	}
-	// latest date is fT
-	// UTC is trailing that's why Local() called
-	want := minST.Local()
-	if !reflect.DeepEqual(got.Local(), want) {
-		t.Errorf("getLastDate() got = %v, want %v", got, want)
-	}
-}
+// tests starts here
 
 // used for calculateCost
 func addFakeEvents(db *sql.DB) error {
@@ -360,3 +165,269 @@ func TestCalculateCost(t *testing.T) {
 		t.Errorf("calculateCost() got = %v, want %v", got, expectedResult)
 	}
 }
+
+func TestCalculateCostEmpty(t *testing.T) {
+	db, err := setup()
+	if err != nil {
+		t.Fatalf("Setting up env error %v ", err)
+	}
+
+	got, err := calculateCost(db)
+	if err != nil {
+		t.Fatalf("calculateCost() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("calculateCost() got = %v, want empty time series", got)
+	}
+}
+
+func TestCalculateCostMalformed(t *testing.T) {
+	db, err := setup()
+	if err != nil {
+		t.Fatalf("Setting up env error %v ", err)
+	}
+	sT, _ := time.Parse(TimeFormat, "2020-05-19 19:19:19")
+	fT, _ := time.Parse(TimeFormat, "2020-05-21 09:00:00")
+	invalid := time.Date(0001, 01, 01, 00, 00, 00, 0000, time.UTC)
+
+	fEvents := []fakeEvent{
+		{tag: "valid", available: 5, sT: sT, fT: fT},
+		{tag: "nostart", available: 3, sT: invalid, fT: fT},
+		{tag: "nofinish", available: 3, sT: sT, fT: invalid},
+		{tag: "reversed", available: 3, sT: fT, fT: sT},
+	}
+	for _, e := range fEvents {
+		if err := insertFakeEvent(e, db); err != nil {
+			t.Fatalf("insertFakeEvent error on events for event %s %v", e.tag, err)
+		}
+	}
+	// closed events are not counted
+	if err := insertFakeEvent(fakeEvent{tag: "closed", available: 11, sT: sT, fT: fT}, db); err != nil {
+		t.Fatalf("insertFakeEvent error on closed event %v", err)
+	}
+	if _, err := db.Exec("UPDATE event SET status=$1 WHERE tag='closed'", Closed); err != nil {
+		t.Fatalf("closing event error %v", err)
+	}
+	// missing available is counted as zero, only its team is counted
+	if err := insertFakeEvent(fakeEvent{tag: "noavailable", sT: sT, fT: sT}, db); err != nil {
+		t.Fatalf("insertFakeEvent error on event without available %v", err)
+	}
+	if _, err := db.Exec("UPDATE event SET available=NULL WHERE tag='noavailable'"); err != nil {
+		t.Fatalf("updating event error %v", err)
+	}
+	var eventId int
+	if err := db.QueryRow(QueryEventId, "noavailable").Scan(&eventId); err != nil {
+		t.Fatalf("Error on getting event id  %v", err)
+	}
+	if err := insertTeamEvent(eventId, db); err != nil {
+		t.Fatalf("insertTeamEvent error %v", err)
+	}
+
+	expectedResult := map[string]int32{
+		"2020-05-19 00:00:00": 5 + 1,
+		"2020-05-20 00:00:00": 5,
+		"2020-05-21 00:00:00": 5,
+	}
+	got, err := calculateCost(db)
+	if err != nil {
+		t.Fatalf("calculateCost() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, expectedResult) {
+		t.Errorf("calculateCost() got = %v, want %v", got, expectedResult)
+	}
+}
+
+func TestUsageTimeSeries(t *testing.T) {
+	db, err := setup()
+	if err != nil {
+		t.Fatalf("Setting up env error %v ", err)
+	}
+	if err := addFakeEvents(db); err != nil {
+		t.Fatalf("error on generating fake events %v", err)
+	}
+
+	point := func(day, group string, vms int32) model.UsagePoint {
+		t, _ := time.Parse(TimeFormat, day)
+		return model.UsagePoint{Time: t, Group: group, VMs: vms}
+	}
+
+	tests := []struct {
+		name string
+		req  *pb.GetUsageTimeSeriesRequest
+		want []model.UsagePoint
+	}{
+		{
+			name: "Daily in range",
+			req:  &pb.GetUsageTimeSeriesRequest{From: "2020-05-18 00:00:00", To: "2020-05-20 12:00:00"},
+			want: []model.UsagePoint{
+				point("2020-05-18 00:00:00", "", 0),
+				point("2020-05-19 00:00:00", "", 10),
+				point("2020-05-20 00:00:00", "", 27),
+			},
+		},
+		{
+			name: "Weekly by event",
+			req:  &pb.GetUsageTimeSeriesRequest{Granularity: "week", GroupBy: "event"},
+			want: []model.UsagePoint{
+				point("2020-05-18 00:00:00", "test1", 10),
+				point("2020-05-18 00:00:00", "test2", 17),
+				point("2020-05-25 00:00:00", "test1", 0),
+				point("2020-05-25 00:00:00", "test2", 17),
+			},
+		},
+		{
+			name: "Monthly by creator",
+			req:  &pb.GetUsageTimeSeriesRequest{Granularity: "month", GroupBy: "createdBy"},
+			want: []model.UsagePoint{
+				point("2020-05-01 00:00:00", "tester", 27),
+			},
+		},
+		{
+			name: "No events with status",
+			req:  &pb.GetUsageTimeSeriesRequest{Status: []int32{int32(Booked)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := usageTimeSeries(db, tt.req)
+			if err != nil {
+				t.Fatalf("usageTimeSeries() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("usageTimeSeries() got = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Time.Equal(tt.want[i].Time) || got[i].Group != tt.want[i].Group || got[i].VMs != tt.want[i].VMs {
+					t.Errorf("usageTimeSeries() point %d got = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestUsageTimeSeriesInvalidRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.GetUsageTimeSeriesRequest
+	}{
+		{name: "Granularity", req: &pb.GetUsageTimeSeriesRequest{Granularity: "minute"}},
+		{name: "Group", req: &pb.GetUsageTimeSeriesRequest{GroupBy: "team"}},
+		{name: "From", req: &pb.GetUsageTimeSeriesRequest{From: "2020-05-18"}},
+		{name: "Reversed range", req: &pb.GetUsageTimeSeriesRequest{From: "2020-05-18 00:00:00", To: "2020-05-17 00:00:00"}},
+		{name: "Too many points", req: &pb.GetUsageTimeSeriesRequest{From: "1900-01-01 00:00:00", To: "2100-01-01 00:00:00", Granularity: "hour"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// request is validated before database is queried
+			if _, err := usageTimeSeries(nil, tt.req); err == nil {
+				t.Errorf("usageTimeSeries() expected error for %v", tt.req)
+			}
+		})
+	}
+}
+
+func TestUsageBuckets(t *testing.T) {
+	parse := func(s string) time.Time {
+		t, _ := time.Parse(TimeFormat, s)
+		return t
+	}
+	tests := []struct {
+		granularity string
+		from, to    string
+		first       string
+		want        int64
+	}{
+		{granularity: "hour", from: "2020-05-18 10:30:00", to: "2020-05-19 10:00:00", first: "2020-05-18 10:00:00", want: 25},
+		{granularity: "day", from: "2020-05-18 10:30:00", to: "2020-05-20 00:00:00", first: "2020-05-18 00:00:00", want: 3},
+		{granularity: "week", from: "2020-05-24 23:00:00", to: "2020-05-25 00:00:00", first: "2020-05-18 00:00:00", want: 2},
+		{granularity: "month", from: "2019-12-31 23:00:00", to: "2020-05-01 00:00:00", first: "2019-12-01 00:00:00", want: 6},
+		{granularity: "hour", from: "0001-01-02 00:00:00", to: "9999-12-31 00:00:00", first: "0001-01-02 00:00:00", want: int64(math.MaxInt64/int64(time.Hour)) + 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.granularity, func(t *testing.T) {
+			first := truncateBucket(parse(tt.from), tt.granularity)
+			if !first.Equal(parse(tt.first)) {
+				t.Errorf("truncateBucket() got = %s, want %s", first, tt.first)
+			}
+			if got := usageBuckets(first, truncateBucket(parse(tt.to), tt.granularity), tt.granularity); got != tt.want {
+				t.Errorf("usageBuckets() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectedLoad(t *testing.T) {
+	db, err := setup()
+	if err != nil {
+		t.Fatalf("Setting up env error %v ", err)
+	}
+	if err := addFakeEvents(db); err != nil {
+		t.Fatalf("error on generating fake events %v", err)
+	}
+	from, _ := time.Parse(TimeFormat, "2020-05-22 10:00:00")
+	to, _ := time.Parse(TimeFormat, "2020-05-24 18:00:00")
+
+	got, err := projectedLoad(db, from, to)
+	if err != nil {
+		t.Fatalf("projectedLoad() error = %v", err)
+	}
+	// test1 is counted by its capacity, test2 by its available VMs and teams
+	want := []int32{10 + 17, 10 + 17, 17}
+	if len(got) != len(want) {
+		t.Fatalf("projectedLoad() got = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i].VMs != want[i] || !got[i].Time.Equal(from.Truncate(24*time.Hour).AddDate(0, 0, i)) {
+			t.Errorf("projectedLoad() point %d got = %v, want %d", i, got[i], want[i])
+		}
+	}
+}
+
+func TestAddBooking(t *testing.T) {
+	db, err := setup()
+	if err != nil {
+		t.Fatalf("Setting up env error %v ", err)
+	}
+	if err := addFakeEvents(db); err != nil {
+		t.Fatalf("error on generating fake events %v", err)
+	}
+
+	// 27 VMs are used on 2020-05-22, at most 40 are allowed
+	fits := func(load []model.UsagePoint) error {
+		for _, p := range load {
+			if p.VMs+10 > 40 {
+				return fmt.Errorf("exceeds on %s", p.Time.Format(TimeFormat))
+			}
+		}
+		return nil
+	}
+	booking := func(tag string) *pb.AddEventRequest {
+		return &pb.AddEventRequest{Tag: tag, Name: tag, Capacity: 10, Status: int32(Booked),
+			StartTime: "2020-05-22 10:00:00", ExpectedFinishTime: "2020-05-22 18:00:00", FinishedAt: "0001-01-01 00:00:00"}
+	}
+
+	// stores do not share their lock, like servers sharing the database
+	errs := make(chan error, 2)
+	for _, tag := range []string{"booked1", "booked2"} {
+		go func(tag string) {
+			_, err := (&store{db: db}).AddBooking(booking(tag), fits)
+			errs <- err
+		}(tag)
+	}
+	var failed int
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			failed++
+		}
+	}
+	if failed != 1 {
+		t.Fatalf("expected one of the concurrent bookings to be rejected, but %d were", failed)
+	}
+	var booked int
+	if err := db.QueryRow("SELECT count(*) FROM event WHERE tag LIKE 'booked%'").Scan(&booked); err != nil {
+		t.Fatal(err)
+	}
+	if booked != 1 {
+		t.Fatalf("expected one booking to be added, but %d were", booked)
+	}
+}
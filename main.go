@@ -1,35 +1,57 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
 	pb "github.com/aau-network-security/haaukins-store/proto"
 	rpc "github.com/aau-network-security/haaukins-store/util"
 	_ "github.com/lib/pq"
-	"log"
-	"net"
 )
 
 const (
 	defaultConfigFile = "config.yml"
-	port = ":50051"
 )
 
+// commands are run instead of the server when given as the first argument
+var commands = map[string]func(args []string) error{
+	"export":  exportCommand,
+	"import":  importCommand,
+	"backup":  backupCommand,
+	"restore": restoreCommand,
+}
+
 func main() {
+	if len(os.Args) > 1 {
+		if cmd, ok := commands[os.Args[1]]; ok {
+			if err := cmd(os.Args[2:]); err != nil {
+				log.Fatalf("%s: %v", os.Args[1], err)
+			}
+			return
+		}
+	}
 
 	confFilePtr := flag.String("config", defaultConfigFile, "configuration file")
+	overrides := rpc.ConfigFlags(flag.CommandLine)
 	flag.Parse()
 
-	c, err := rpc.NewConfigFromFile(*confFilePtr)
+	c, err := rpc.NewConfigFromFile(*confFilePtr, overrides)
 	if err != nil {
 		log.Fatalf("unable to read configuration file \"%s\": %s\n", *confFilePtr, err)
 	}
 
 	s, err := rpc.InitilizegRPCServer(c)
 	if err != nil {
-		return
+		log.Fatalf("failed to initialize server: %v", err)
 	}
-	lis, err := net.Listen("tcp", port)
+	lis, err := net.Listen("tcp", c.Host)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
@@ -41,9 +63,85 @@ func main() {
 
 	gRPCServer := s.GetGRPCServer(opts...)
 	pb.RegisterStoreServer(gRPCServer, s)
-	fmt.Println("waiting client")
-	if err := gRPCServer.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+
+	// background workers are stopped by cancelling ctx on shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	runWorker := func(name string, run func(context.Context) error) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			if err := run(ctx); err != nil {
+				log.Fatalf("failed to serve %s: %v", name, err)
+			}
+		}()
 	}
 
+	runWorker("health checks", func(ctx context.Context) error {
+		s.MonitorHealth(ctx)
+		return nil
+	})
+	if c.Scheduler.Enabled {
+		runWorker("scheduler", func(ctx context.Context) error {
+			s.RunScheduler(ctx)
+			return nil
+		})
+	}
+	if c.InactiveTeams.Enabled {
+		runWorker("inactive teams", func(ctx context.Context) error {
+			s.RunInactivityMonitor(ctx)
+			return nil
+		})
+	}
+	if c.Retention.AnonymizeAfter > 0 {
+		runWorker("retention", func(ctx context.Context) error {
+			s.RunRetention(ctx)
+			return nil
+		})
+	}
+	if c.Metrics.Host != "" {
+		runWorker("metrics", func(ctx context.Context) error {
+			return s.ServeMetrics(ctx, c)
+		})
+	}
+	if c.Gateway.Host != "" {
+		runWorker("gateway", func(ctx context.Context) error {
+			return s.ServeGateway(ctx, c)
+		})
+	}
+
+	go func() {
+		fmt.Printf("waiting client on %s\n", c.Host)
+		if err := gRPCServer.Serve(lis); err != nil {
+			log.Fatalf("failed to serve: %v", err)
+		}
+	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP)
+	for sig := range signals {
+		if sig != syscall.SIGHUP {
+			log.Printf("Received %s, shutting down", sig)
+			break
+		}
+		// configuration is read again, e.g. after certificates are renewed
+		log.Printf("Received %s, reloading configuration", sig)
+		conf, err := rpc.NewConfigFromFile(*confFilePtr, overrides)
+		if err != nil {
+			log.Printf("ERR: Error reading configuration, previous one is kept: %s", err)
+			continue
+		}
+		if err := s.Reload(conf); err != nil {
+			log.Printf("ERR: Error reloading configuration: %s", err)
+		}
+	}
+
+	s.SetNotServing()
+	rpc.Drain(gRPCServer, c.ShutdownTimeout)
+	cancel()
+	workers.Wait()
+	if err := s.Close(); err != nil {
+		log.Printf("ERR: Error closing database connections: %s", err.Error())
+	}
+	log.Printf("Server is stopped")
 }
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type Event struct {
 	Id                 uint //DB Primary Key
 	Tag                string
@@ -30,21 +32,220 @@ type Team struct {
 	SolvedChallenges string
 }
 
+// UsagePoint is the number of VMs used by events of a group in the interval starting at Time
+type UsagePoint struct {
+	Time  time.Time
+	Group string
+	VMs   int32
+}
+
+// StatusChange is an entry of event status history
+type StatusChange struct {
+	Status         int32
+	PreviousStatus int32
+	ChangedAt      time.Time
+	Source         string // api or scheduler
+	Reason         string
+}
+
+// InactiveTeam is a team which has not accessed its event for a while,
+// FlaggedAt is zero when the inactive teams policy has not flagged it
+type InactiveTeam struct {
+	EventTag   string    `json:"eventTag"`
+	Id         string    `json:"teamId"`
+	Name       string    `json:"name"`
+	LastAccess time.Time `json:"lastAccess"`
+	FlaggedAt  time.Time `json:"flaggedAt"`
+}
+
+// ErasedTeam is a team whose email and name are anonymized
+type ErasedTeam struct {
+	EventTag string
+	Id       string
+}
+
+// EventArchive is a portable export of an event with its teams and status history,
+// fields are named in JSON so archives could be read without this repository
+type EventArchive struct {
+	Version       int              `json:"version"`
+	ExportedAt    time.Time        `json:"exportedAt"`
+	Secrets       bool             `json:"secrets"` // whether secret key and passwords are included
+	Event         ArchivedEvent    `json:"event"`
+	Teams         []ArchivedTeam   `json:"teams"`
+	StatusHistory []ArchivedStatus `json:"statusHistory"`
+}
+
+type ArchivedEvent struct {
+	Tag                string `json:"tag"`
+	Name               string `json:"name"`
+	Frontends          string `json:"frontends"`
+	Exercises          string `json:"exercises"`
+	DisabledExercises  string `json:"disabledExercises"`
+	Available          uint   `json:"available"`
+	Capacity           uint   `json:"capacity"`
+	Status             int32  `json:"status"`
+	StartedAt          string `json:"startedAt"`
+	ExpectedFinishTime string `json:"expectedFinishTime"`
+	FinishedAt         string `json:"finishedAt"`
+	CreatedBy          string `json:"createdBy"`
+	OnlyVPN            bool   `json:"onlyVPN"`
+	SecretKey          string `json:"secretKey,omitempty"`
+}
+
+// ArchivedTeam is a team with its members and solves, password is the hash stored in the team table
+type ArchivedTeam struct {
+	Id         string          `json:"id"`
+	Name       string          `json:"name"`
+	Email      string          `json:"email"`
+	Password   string          `json:"password,omitempty"`
+	CreatedAt  string          `json:"createdAt"`
+	LastAccess string          `json:"lastAccess"`
+	Solves     []ArchivedSolve `json:"solves"`
+}
+
+type ArchivedSolve struct {
+	Challenge   string `json:"challenge"`
+	CompletedAt string `json:"completedAt"`
+}
+
+type ArchivedStatus struct {
+	Status         int32     `json:"status"`
+	PreviousStatus int32     `json:"previousStatus"`
+	ChangedAt      time.Time `json:"changedAt"`
+	Source         string    `json:"source"`
+	Reason         string    `json:"reason"`
+}
+
+// AuditEntry is a record of a mutating RPC call
+type AuditEntry struct {
+	CreatedAt    time.Time `json:"createdAt"`
+	Method       string    `json:"method"`
+	Caller       string    `json:"caller"`
+	EventTag     string    `json:"eventTag"`
+	TeamId       string    `json:"teamId"`
+	Request      string    `json:"request"`
+	Code         string    `json:"code"`
+	ErrorMessage string    `json:"errorMessage"`
+}
+
+// Backup is a logical copy of every event with its teams and status history,
+// and of the audit log, secrets are always included
+type Backup struct {
+	Version   int            `json:"version"`
+	CreatedAt time.Time      `json:"createdAt"`
+	Events    []EventArchive `json:"events"`
+	AuditLog  []AuditEntry   `json:"auditLog"`
+}
+
 type Config struct {
-	Host      string `yaml:"host"`
-	AuthKey   string `yaml:"auth-key"`
-	SigninKey string `yaml:"signin-key"`
-	DB        struct {
-		Host string `yaml:"host"`
-		User string `yaml:"user"`
-		Pass string `yaml:"pass"`
-		Name string `yaml:"db_name"`
-		Port uint   `yaml:"db_port"`
+	Host          string `yaml:"host"`
+	AuthKey       string `yaml:"auth-key"`
+	AuthKeyFile   string `yaml:"auth-key-file"`
+	SigninKey     string `yaml:"signin-key"`
+	SigninKeyFile string `yaml:"signin-key-file"`
+	DB            struct {
+		Host     string `yaml:"host"`
+		User     string `yaml:"user"`
+		Pass     string `yaml:"pass"`
+		PassFile string `yaml:"pass-file"`
+		Name     string `yaml:"db_name"`
+		Port     uint   `yaml:"db_port"`
+
+		// ConnectionString is passed to the driver as it is,
+		// connection parameters above are ignored when it is given
+		ConnectionString string        `yaml:"connection-string"`
+		SSLMode          string        `yaml:"sslmode"`
+		SSLRootCert      string        `yaml:"sslrootcert"`
+		SSLCert          string        `yaml:"sslcert"`
+		SSLKey           string        `yaml:"sslkey"`
+		MaxOpenConns     int           `yaml:"max-open-conns"`
+		MaxIdleConns     int           `yaml:"max-idle-conns"`
+		ConnMaxLifetime  time.Duration `yaml:"conn-max-lifetime"`
+		ConnMaxIdleTime  time.Duration `yaml:"conn-max-idle-time"`
+		StatementTimeout time.Duration `yaml:"statement-timeout"`
+		Retry            DBRetry       `yaml:"retry"`
 	} `yaml:"db"`
 	TLS struct {
 		Enabled  bool   `yaml:"enabled"`
 		CertFile string `yaml:"certfile"`
 		CertKey  string `yaml:"certkey"`
 		CAFile   string `yaml:"cafile"`
-	} `tls:"tls,omitempty"`
+	} `yaml:"tls"`
+	JWKS struct {
+		File        string `yaml:"file"`
+		Issuer      string `yaml:"issuer"`
+		Audience    string `yaml:"audience"`
+		DisableHMAC bool   `yaml:"disable-hmac"`
+	} `yaml:"jwks"`
+	ClientCerts struct {
+		Mode       string         `yaml:"mode"`
+		Identities []CertIdentity `yaml:"identities"`
+	} `yaml:"client-certs"`
+	Audit struct {
+		Disabled bool     `yaml:"disabled"`
+		Exclude  []string `yaml:"exclude"`
+	} `yaml:"audit"`
+	RateLimit struct {
+		RateLimit `yaml:",inline"`
+		Methods   map[string]RateLimit `yaml:"methods"`
+	} `yaml:"rate-limit"`
+	Gateway struct {
+		Host string `yaml:"host"`
+	} `yaml:"gateway"`
+	Metrics struct {
+		Host string `yaml:"host"`
+	} `yaml:"metrics"`
+	Capacity struct {
+		VMLimit           int            `yaml:"vm-limit"`
+		Weekdays          map[string]int `yaml:"weekdays"`
+		Days              map[string]int `yaml:"days"`
+		RejectOverbooking bool           `yaml:"reject-overbooking"`
+	} `yaml:"capacity"`
+	Scheduler struct {
+		Enabled     bool          `yaml:"enabled"`
+		Interval    time.Duration `yaml:"interval"`
+		Expired     string        `yaml:"expired"`
+		GracePeriod time.Duration `yaml:"grace-period"`
+	} `yaml:"scheduler"`
+	InactiveTeams struct {
+		Enabled     bool          `yaml:"enabled"`
+		Interval    time.Duration `yaml:"interval"`
+		InactiveFor time.Duration `yaml:"inactive-for"`
+		Webhook     string        `yaml:"webhook"`
+	} `yaml:"inactive-teams"`
+	Retention struct {
+		AnonymizeAfter time.Duration `yaml:"anonymize-after"`
+		Interval       time.Duration `yaml:"interval"`
+	} `yaml:"retention"`
+	Backup struct {
+		Key     string `yaml:"key"`
+		KeyFile string `yaml:"key-file"`
+	} `yaml:"backup"`
+	ShutdownTimeout time.Duration `yaml:"shutdown-timeout"`
+}
+
+// CertIdentity maps subject of a client certificate to caller identity
+type CertIdentity struct {
+	CN     string   `yaml:"cn"`
+	SAN    []string `yaml:"san"`
+	Name   string   `yaml:"name"`
+	Scopes []string `yaml:"scopes"`
+}
+
+// DBRetry defines how database connection is retried on startup
+// and how many times reads are attempted on transient errors
+type DBRetry struct {
+	InitialInterval time.Duration `yaml:"initial-interval"`
+	MaxInterval     time.Duration `yaml:"max-interval"`
+	StartupTimeout  time.Duration `yaml:"startup-timeout"`
+	DegradedStart   bool          `yaml:"degraded-start"`
+	ReadAttempts    int           `yaml:"read-attempts"`
+}
+
+// RateLimit is token bucket rate (requests per second) with burst size
+// and maximum number of concurrent requests, zero values mean unlimited
+type RateLimit struct {
+	Rate        float64 `yaml:"rate"`
+	Burst       int     `yaml:"burst"`
+	MaxInFlight int     `yaml:"max-in-flight"`
 }
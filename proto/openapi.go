@@ -0,0 +1,9 @@
+package proto
+
+import _ "embed"
+
+// OpenAPI is the OpenAPI (swagger) document of the REST/JSON gateway,
+// generated from HTTP annotations in store.proto
+//
+//go:embed store.swagger.json
+var OpenAPI []byte
@@ -7,6 +7,7 @@
 package proto
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,17 +21,21 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type UpdateExerciseRequest struct {
+type QueryAuditLogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventTag   string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
-	Challenges string `protobuf:"bytes,2,opt,name=challenges,proto3" json:"challenges,omitempty"` // challenges should be exactly in this format :  [ ,ftp,microcms,scan    ]
+	// from and to are in "2006-01-02 15:04:05" format, empty means no limit
+	From     string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To       string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Caller   string `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	EventTag string `protobuf:"bytes,4,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Limit    int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"` // defaults to 100 entries
 }
 
-func (x *UpdateExerciseRequest) Reset() {
-	*x = UpdateExerciseRequest{}
+func (x *QueryAuditLogRequest) Reset() {
+	*x = QueryAuditLogRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -38,13 +43,13 @@ func (x *UpdateExerciseRequest) Reset() {
 	}
 }
 
-func (x *UpdateExerciseRequest) String() string {
+func (x *QueryAuditLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExerciseRequest) ProtoMessage() {}
+func (*QueryAuditLogRequest) ProtoMessage() {}
 
-func (x *UpdateExerciseRequest) ProtoReflect() protoreflect.Message {
+func (x *QueryAuditLogRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -56,35 +61,57 @@ func (x *UpdateExerciseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExerciseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateExerciseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *UpdateExerciseRequest) GetEventTag() string {
+func (x *QueryAuditLogRequest) GetFrom() string {
 	if x != nil {
-		return x.EventTag
+		return x.From
 	}
 	return ""
 }
 
-func (x *UpdateExerciseRequest) GetChallenges() string {
+func (x *QueryAuditLogRequest) GetTo() string {
 	if x != nil {
-		return x.Challenges
+		return x.To
 	}
 	return ""
 }
 
-type UpdateExerciseResponse struct {
+func (x *QueryAuditLogRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type QueryAuditLogResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Entries      []*QueryAuditLogResponse_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	ErrorMessage string                         `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
 }
 
-func (x *UpdateExerciseResponse) Reset() {
-	*x = UpdateExerciseResponse{}
+func (x *QueryAuditLogResponse) Reset() {
+	*x = QueryAuditLogResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -92,13 +119,13 @@ func (x *UpdateExerciseResponse) Reset() {
 	}
 }
 
-func (x *UpdateExerciseResponse) String() string {
+func (x *QueryAuditLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExerciseResponse) ProtoMessage() {}
+func (*QueryAuditLogResponse) ProtoMessage() {}
 
-func (x *UpdateExerciseResponse) ProtoReflect() protoreflect.Message {
+func (x *QueryAuditLogResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -110,26 +137,37 @@ func (x *UpdateExerciseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExerciseResponse.ProtoReflect.Descriptor instead.
-func (*UpdateExerciseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *UpdateExerciseResponse) GetMessage() string {
+func (x *QueryAuditLogResponse) GetEntries() []*QueryAuditLogResponse_Entry {
 	if x != nil {
-		return x.Message
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *QueryAuditLogResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-type EmptyRequest struct {
+type ExportEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	EventTag       string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Format         string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`                  // json or tar.gz (JSON and CSV files), defaults to json
+	IncludeSecrets bool   `protobuf:"varint,3,opt,name=includeSecrets,proto3" json:"includeSecrets,omitempty"` // include secret key and password hashes, requires admin scope
 }
 
-func (x *EmptyRequest) Reset() {
-	*x = EmptyRequest{}
+func (x *ExportEventRequest) Reset() {
+	*x = ExportEventRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -137,13 +175,13 @@ func (x *EmptyRequest) Reset() {
 	}
 }
 
-func (x *EmptyRequest) String() string {
+func (x *ExportEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmptyRequest) ProtoMessage() {}
+func (*ExportEventRequest) ProtoMessage() {}
 
-func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -155,22 +193,44 @@ func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
-func (*EmptyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportEventRequest.ProtoReflect.Descriptor instead.
+func (*ExportEventRequest) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{2}
 }
 
-type DelTeamRequest struct {
+func (x *ExportEventRequest) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+func (x *ExportEventRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportEventRequest) GetIncludeSecrets() bool {
+	if x != nil {
+		return x.IncludeSecrets
+	}
+	return false
+}
+
+type ExportEventResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EvTag  string `protobuf:"bytes,1,opt,name=evTag,proto3" json:"evTag,omitempty"`
-	TeamId string `protobuf:"bytes,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Archive      []byte `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	FileName     string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	ErrorMessage string `protobuf:"bytes,3,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
 }
 
-func (x *DelTeamRequest) Reset() {
-	*x = DelTeamRequest{}
+func (x *ExportEventResponse) Reset() {
+	*x = ExportEventResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -178,13 +238,13 @@ func (x *DelTeamRequest) Reset() {
 	}
 }
 
-func (x *DelTeamRequest) String() string {
+func (x *ExportEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelTeamRequest) ProtoMessage() {}
+func (*ExportEventResponse) ProtoMessage() {}
 
-func (x *DelTeamRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -196,35 +256,43 @@ func (x *DelTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelTeamRequest.ProtoReflect.Descriptor instead.
-func (*DelTeamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportEventResponse.ProtoReflect.Descriptor instead.
+func (*ExportEventResponse) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *DelTeamRequest) GetEvTag() string {
+func (x *ExportEventResponse) GetArchive() []byte {
 	if x != nil {
-		return x.EvTag
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ExportEventResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *DelTeamRequest) GetTeamId() string {
+func (x *ExportEventResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.TeamId
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-type DelTeamResp struct {
+type ImportEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Archive []byte `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"` // archive written by ExportEvent, either json or tar.gz
+	NewTag  string `protobuf:"bytes,2,opt,name=newTag,proto3" json:"newTag,omitempty"`   // imports the event with this tag instead of the archived one
 }
 
-func (x *DelTeamResp) Reset() {
-	*x = DelTeamResp{}
+func (x *ImportEventRequest) Reset() {
+	*x = ImportEventRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -232,13 +300,13 @@ func (x *DelTeamResp) Reset() {
 	}
 }
 
-func (x *DelTeamResp) String() string {
+func (x *ImportEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelTeamResp) ProtoMessage() {}
+func (*ImportEventRequest) ProtoMessage() {}
 
-func (x *DelTeamResp) ProtoReflect() protoreflect.Message {
+func (x *ImportEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -250,30 +318,37 @@ func (x *DelTeamResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelTeamResp.ProtoReflect.Descriptor instead.
-func (*DelTeamResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportEventRequest.ProtoReflect.Descriptor instead.
+func (*ImportEventRequest) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DelTeamResp) GetMessage() string {
+func (x *ImportEventRequest) GetArchive() []byte {
 	if x != nil {
-		return x.Message
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ImportEventRequest) GetNewTag() string {
+	if x != nil {
+		return x.NewTag
 	}
 	return ""
 }
 
-type UpdateTeamPassRequest struct {
+type ImportEventResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EncryptedPass string `protobuf:"bytes,1,opt,name=encryptedPass,proto3" json:"encryptedPass,omitempty"`
-	TeamID        string `protobuf:"bytes,2,opt,name=teamID,proto3" json:"teamID,omitempty"`
-	EventID       int32  `protobuf:"varint,3,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	EventTag     string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Teams        int32  `protobuf:"varint,2,opt,name=teams,proto3" json:"teams,omitempty"`
+	ErrorMessage string `protobuf:"bytes,3,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
 }
 
-func (x *UpdateTeamPassRequest) Reset() {
-	*x = UpdateTeamPassRequest{}
+func (x *ImportEventResponse) Reset() {
+	*x = ImportEventResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -281,13 +356,13 @@ func (x *UpdateTeamPassRequest) Reset() {
 	}
 }
 
-func (x *UpdateTeamPassRequest) String() string {
+func (x *ImportEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTeamPassRequest) ProtoMessage() {}
+func (*ImportEventResponse) ProtoMessage() {}
 
-func (x *UpdateTeamPassRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -299,42 +374,42 @@ func (x *UpdateTeamPassRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTeamPassRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTeamPassRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportEventResponse.ProtoReflect.Descriptor instead.
+func (*ImportEventResponse) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UpdateTeamPassRequest) GetEncryptedPass() string {
+func (x *ImportEventResponse) GetEventTag() string {
 	if x != nil {
-		return x.EncryptedPass
+		return x.EventTag
 	}
 	return ""
 }
 
-func (x *UpdateTeamPassRequest) GetTeamID() string {
+func (x *ImportEventResponse) GetTeams() int32 {
 	if x != nil {
-		return x.TeamID
+		return x.Teams
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateTeamPassRequest) GetEventID() int32 {
+func (x *ImportEventResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.EventID
+		return x.ErrorMessage
 	}
-	return 0
+	return ""
 }
 
-type GetEventIDReq struct {
+type EraseTeamDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"` // compared case insensitively
 }
 
-func (x *GetEventIDReq) Reset() {
-	*x = GetEventIDReq{}
+func (x *EraseTeamDataRequest) Reset() {
+	*x = EraseTeamDataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -342,13 +417,13 @@ func (x *GetEventIDReq) Reset() {
 	}
 }
 
-func (x *GetEventIDReq) String() string {
+func (x *EraseTeamDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventIDReq) ProtoMessage() {}
+func (*EraseTeamDataRequest) ProtoMessage() {}
 
-func (x *GetEventIDReq) ProtoReflect() protoreflect.Message {
+func (x *EraseTeamDataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -360,28 +435,29 @@ func (x *GetEventIDReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventIDReq.ProtoReflect.Descriptor instead.
-func (*GetEventIDReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use EraseTeamDataRequest.ProtoReflect.Descriptor instead.
+func (*EraseTeamDataRequest) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetEventIDReq) GetEventTag() string {
+func (x *EraseTeamDataRequest) GetEmail() string {
 	if x != nil {
-		return x.EventTag
+		return x.Email
 	}
 	return ""
 }
 
-type GetEventIDResp struct {
+type EraseTeamDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventID int32 `protobuf:"varint,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Teams        []*EraseTeamDataResponse_Team `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"` // anonymized teams, solves of them are kept
+	ErrorMessage string                        `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
 }
 
-func (x *GetEventIDResp) Reset() {
-	*x = GetEventIDResp{}
+func (x *EraseTeamDataResponse) Reset() {
+	*x = EraseTeamDataResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -389,13 +465,13 @@ func (x *GetEventIDResp) Reset() {
 	}
 }
 
-func (x *GetEventIDResp) String() string {
+func (x *EraseTeamDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventIDResp) ProtoMessage() {}
+func (*EraseTeamDataResponse) ProtoMessage() {}
 
-func (x *GetEventIDResp) ProtoReflect() protoreflect.Message {
+func (x *EraseTeamDataResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,28 +483,39 @@ func (x *GetEventIDResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventIDResp.ProtoReflect.Descriptor instead.
-func (*GetEventIDResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use EraseTeamDataResponse.ProtoReflect.Descriptor instead.
+func (*EraseTeamDataResponse) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetEventIDResp) GetEventID() int32 {
+func (x *EraseTeamDataResponse) GetTeams() []*EraseTeamDataResponse_Team {
 	if x != nil {
-		return x.EventID
+		return x.Teams
 	}
-	return 0
+	return nil
 }
 
-type GetTimeSeriesResponse struct {
+func (x *EraseTeamDataResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetEventStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timeseries map[string]int32 `protobuf:"bytes,1,rep,name=timeseries,proto3" json:"timeseries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	// teams which accessed the event at or after activeSince are active, in "2006-01-02 15:04:05" format,
+	// empty means teams which accessed the event after they were created
+	ActiveSince   string `protobuf:"bytes,2,opt,name=activeSince,proto3" json:"activeSince,omitempty"`
+	SolveTimeFrom string `protobuf:"bytes,3,opt,name=solveTimeFrom,proto3" json:"solveTimeFrom,omitempty"` // team (creation of the team, default) or event (start of the event)
 }
 
-func (x *GetTimeSeriesResponse) Reset() {
-	*x = GetTimeSeriesResponse{}
+func (x *GetEventStatsRequest) Reset() {
+	*x = GetEventStatsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -436,13 +523,13 @@ func (x *GetTimeSeriesResponse) Reset() {
 	}
 }
 
-func (x *GetTimeSeriesResponse) String() string {
+func (x *GetEventStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTimeSeriesResponse) ProtoMessage() {}
+func (*GetEventStatsRequest) ProtoMessage() {}
 
-func (x *GetTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetEventStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -454,28 +541,48 @@ func (x *GetTimeSeriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTimeSeriesResponse.ProtoReflect.Descriptor instead.
-func (*GetTimeSeriesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEventStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventStatsRequest) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetTimeSeriesResponse) GetTimeseries() map[string]int32 {
+func (x *GetEventStatsRequest) GetEventTag() string {
 	if x != nil {
-		return x.Timeseries
+		return x.EventTag
 	}
-	return nil
+	return ""
 }
 
-type GetEventStatusRequest struct {
+func (x *GetEventStatsRequest) GetActiveSince() string {
+	if x != nil {
+		return x.ActiveSince
+	}
+	return ""
+}
+
+func (x *GetEventStatsRequest) GetSolveTimeFrom() string {
+	if x != nil {
+		return x.SolveTimeFrom
+	}
+	return ""
+}
+
+type GetEventStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Teams              int32                              `protobuf:"varint,1,opt,name=teams,proto3" json:"teams,omitempty"`
+	ActiveTeams        int32                              `protobuf:"varint,2,opt,name=activeTeams,proto3" json:"activeTeams,omitempty"`
+	Solves             int32                              `protobuf:"varint,3,opt,name=solves,proto3" json:"solves,omitempty"`
+	ZeroSolveRatio     float64                            `protobuf:"fixed64,4,opt,name=zeroSolveRatio,proto3" json:"zeroSolveRatio,omitempty"`        // fraction of teams without any solves
+	MedianSolveSeconds int64                              `protobuf:"varint,5,opt,name=medianSolveSeconds,proto3" json:"medianSolveSeconds,omitempty"` // median time to solve of every solve
+	Challenges         []*GetEventStatsResponse_Challenge `protobuf:"bytes,6,rep,name=challenges,proto3" json:"challenges,omitempty"`                  // exercises of the event and solved challenges, ordered by solves
+	ErrorMessage       string                             `protobuf:"bytes,7,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
 }
 
-func (x *GetEventStatusRequest) Reset() {
-	*x = GetEventStatusRequest{}
+func (x *GetEventStatsResponse) Reset() {
+	*x = GetEventStatsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -483,13 +590,13 @@ func (x *GetEventStatusRequest) Reset() {
 	}
 }
 
-func (x *GetEventStatusRequest) String() string {
+func (x *GetEventStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventStatusRequest) ProtoMessage() {}
+func (*GetEventStatsResponse) ProtoMessage() {}
 
-func (x *GetEventStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *GetEventStatsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -501,29 +608,73 @@ func (x *GetEventStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetEventStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEventStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventStatsResponse) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetEventStatusRequest) GetEventTag() string {
+func (x *GetEventStatsResponse) GetTeams() int32 {
 	if x != nil {
-		return x.EventTag
+		return x.Teams
+	}
+	return 0
+}
+
+func (x *GetEventStatsResponse) GetActiveTeams() int32 {
+	if x != nil {
+		return x.ActiveTeams
+	}
+	return 0
+}
+
+func (x *GetEventStatsResponse) GetSolves() int32 {
+	if x != nil {
+		return x.Solves
+	}
+	return 0
+}
+
+func (x *GetEventStatsResponse) GetZeroSolveRatio() float64 {
+	if x != nil {
+		return x.ZeroSolveRatio
+	}
+	return 0
+}
+
+func (x *GetEventStatsResponse) GetMedianSolveSeconds() int64 {
+	if x != nil {
+		return x.MedianSolveSeconds
+	}
+	return 0
+}
+
+func (x *GetEventStatsResponse) GetChallenges() []*GetEventStatsResponse_Challenge {
+	if x != nil {
+		return x.Challenges
+	}
+	return nil
+}
+
+func (x *GetEventStatsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-type GetEventByTagReq struct {
+type GetSolveTimelineRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
-	Status   int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	EventTag string           `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Interval string           `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`                                                                                      // length of the intervals, e.g. 10m or 1h, defaults to 1h
+	TopTeams int32            `protobuf:"varint,3,opt,name=topTeams,proto3" json:"topTeams,omitempty"`                                                                                     // number of teams with most points, defaults to 10
+	Points   map[string]int32 `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // points of challenges, other challenges are worth 1 point
 }
 
-func (x *GetEventByTagReq) Reset() {
-	*x = GetEventByTagReq{}
+func (x *GetSolveTimelineRequest) Reset() {
+	*x = GetSolveTimelineRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -531,13 +682,13 @@ func (x *GetEventByTagReq) Reset() {
 	}
 }
 
-func (x *GetEventByTagReq) String() string {
+func (x *GetSolveTimelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventByTagReq) ProtoMessage() {}
+func (*GetSolveTimelineRequest) ProtoMessage() {}
 
-func (x *GetEventByTagReq) ProtoReflect() protoreflect.Message {
+func (x *GetSolveTimelineRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,35 +700,51 @@ func (x *GetEventByTagReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventByTagReq.ProtoReflect.Descriptor instead.
-func (*GetEventByTagReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSolveTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetSolveTimelineRequest) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetEventByTagReq) GetEventTag() string {
+func (x *GetSolveTimelineRequest) GetEventTag() string {
 	if x != nil {
 		return x.EventTag
 	}
 	return ""
 }
 
-func (x *GetEventByTagReq) GetStatus() int32 {
+func (x *GetSolveTimelineRequest) GetInterval() string {
 	if x != nil {
-		return x.Status
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetSolveTimelineRequest) GetTopTeams() int32 {
+	if x != nil {
+		return x.TopTeams
 	}
 	return 0
 }
 
-type GetEventByTagResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *GetSolveTimelineRequest) GetPoints() map[string]int32 {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type GetSolveTimelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsExist bool `protobuf:"varint,1,opt,name=isExist,proto3" json:"isExist,omitempty"`
+	Buckets      []*GetSolveTimelineResponse_Bucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"` // from start of the event or the first solve to the last solve
+	Teams        []*GetSolveTimelineResponse_Team   `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`     // ordered by points, ties by the time of the last solve
+	ErrorMessage string                             `protobuf:"bytes,3,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
 }
 
-func (x *GetEventByTagResp) Reset() {
-	*x = GetEventByTagResp{}
+func (x *GetSolveTimelineResponse) Reset() {
+	*x = GetSolveTimelineResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -585,13 +752,13 @@ func (x *GetEventByTagResp) Reset() {
 	}
 }
 
-func (x *GetEventByTagResp) String() string {
+func (x *GetSolveTimelineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventByTagResp) ProtoMessage() {}
+func (*GetSolveTimelineResponse) ProtoMessage() {}
 
-func (x *GetEventByTagResp) ProtoReflect() protoreflect.Message {
+func (x *GetSolveTimelineResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,29 +770,43 @@ func (x *GetEventByTagResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventByTagResp.ProtoReflect.Descriptor instead.
-func (*GetEventByTagResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSolveTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetSolveTimelineResponse) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetEventByTagResp) GetIsExist() bool {
+func (x *GetSolveTimelineResponse) GetBuckets() []*GetSolveTimelineResponse_Bucket {
 	if x != nil {
-		return x.IsExist
+		return x.Buckets
 	}
-	return false
+	return nil
 }
 
-type DropEventReq struct {
+func (x *GetSolveTimelineResponse) GetTeams() []*GetSolveTimelineResponse_Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *GetSolveTimelineResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type UpdateExerciseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tag    string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Status int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	EventTag   string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Challenges string `protobuf:"bytes,2,opt,name=challenges,proto3" json:"challenges,omitempty"` // challenges should be exactly in this format :  [ ,ftp,microcms,scan    ]
 }
 
-func (x *DropEventReq) Reset() {
-	*x = DropEventReq{}
+func (x *UpdateExerciseRequest) Reset() {
+	*x = UpdateExerciseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -633,13 +814,13 @@ func (x *DropEventReq) Reset() {
 	}
 }
 
-func (x *DropEventReq) String() string {
+func (x *UpdateExerciseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DropEventReq) ProtoMessage() {}
+func (*UpdateExerciseRequest) ProtoMessage() {}
 
-func (x *DropEventReq) ProtoReflect() protoreflect.Message {
+func (x *UpdateExerciseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -651,35 +832,35 @@ func (x *DropEventReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DropEventReq.ProtoReflect.Descriptor instead.
-func (*DropEventReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateExerciseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExerciseRequest) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DropEventReq) GetTag() string {
+func (x *UpdateExerciseRequest) GetEventTag() string {
 	if x != nil {
-		return x.Tag
+		return x.EventTag
 	}
 	return ""
 }
 
-func (x *DropEventReq) GetStatus() int32 {
+func (x *UpdateExerciseRequest) GetChallenges() string {
 	if x != nil {
-		return x.Status
+		return x.Challenges
 	}
-	return 0
+	return ""
 }
 
-type DropEventResp struct {
+type UpdateExerciseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsDropped bool `protobuf:"varint,1,opt,name=isDropped,proto3" json:"isDropped,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *DropEventResp) Reset() {
-	*x = DropEventResp{}
+func (x *UpdateExerciseResponse) Reset() {
+	*x = UpdateExerciseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -687,13 +868,13 @@ func (x *DropEventResp) Reset() {
 	}
 }
 
-func (x *DropEventResp) String() string {
+func (x *UpdateExerciseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DropEventResp) ProtoMessage() {}
+func (*UpdateExerciseResponse) ProtoMessage() {}
 
-func (x *DropEventResp) ProtoReflect() protoreflect.Message {
+func (x *UpdateExerciseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -705,33 +886,26 @@ func (x *DropEventResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DropEventResp.ProtoReflect.Descriptor instead.
-func (*DropEventResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateExerciseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateExerciseResponse) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DropEventResp) GetIsDropped() bool {
+func (x *UpdateExerciseResponse) GetMessage() string {
 	if x != nil {
-		return x.IsDropped
+		return x.Message
 	}
-	return false
+	return ""
 }
 
-type GetEventRequest struct {
+type EmptyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// this will indicate that whether all events requested or some by status
-	// status 0  > Running Events (which set as Running)
-	// status 1  > Suspended Events (which set as Suspended)
-	// status 2  > Booked Events (which are booked by client)
-	// status 3  > Closed Events (which are finished and closed )
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *GetEventRequest) Reset() {
-	*x = GetEventRequest{}
+func (x *EmptyRequest) Reset() {
+	*x = EmptyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -739,13 +913,13 @@ func (x *GetEventRequest) Reset() {
 	}
 }
 
-func (x *GetEventRequest) String() string {
+func (x *EmptyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventRequest) ProtoMessage() {}
+func (*EmptyRequest) ProtoMessage() {}
 
-func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -757,29 +931,22 @@ func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
-func (*GetEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
+func (*EmptyRequest) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetEventRequest) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-type GetEventByUserReq struct {
+type DelTeamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	User   string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	EvTag  string `protobuf:"bytes,1,opt,name=evTag,proto3" json:"evTag,omitempty"`
+	TeamId string `protobuf:"bytes,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
 }
 
-func (x *GetEventByUserReq) Reset() {
-	*x = GetEventByUserReq{}
+func (x *DelTeamRequest) Reset() {
+	*x = DelTeamRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -787,13 +954,13 @@ func (x *GetEventByUserReq) Reset() {
 	}
 }
 
-func (x *GetEventByUserReq) String() string {
+func (x *DelTeamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventByUserReq) ProtoMessage() {}
+func (*DelTeamRequest) ProtoMessage() {}
 
-func (x *GetEventByUserReq) ProtoReflect() protoreflect.Message {
+func (x *DelTeamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -805,36 +972,36 @@ func (x *GetEventByUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventByUserReq.ProtoReflect.Descriptor instead.
-func (*GetEventByUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelTeamRequest.ProtoReflect.Descriptor instead.
+func (*DelTeamRequest) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetEventByUserReq) GetStatus() int32 {
+func (x *DelTeamRequest) GetEvTag() string {
 	if x != nil {
-		return x.Status
+		return x.EvTag
 	}
-	return 0
+	return ""
 }
 
-func (x *GetEventByUserReq) GetUser() string {
+func (x *DelTeamRequest) GetTeamId() string {
 	if x != nil {
-		return x.User
+		return x.TeamId
 	}
 	return ""
 }
 
-type SetEventStatusRequest struct {
+type DelTeamResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
-	Status   int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message      string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
 }
 
-func (x *SetEventStatusRequest) Reset() {
-	*x = SetEventStatusRequest{}
+func (x *DelTeamResp) Reset() {
+	*x = DelTeamResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -842,13 +1009,13 @@ func (x *SetEventStatusRequest) Reset() {
 	}
 }
 
-func (x *SetEventStatusRequest) String() string {
+func (x *DelTeamResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetEventStatusRequest) ProtoMessage() {}
+func (*DelTeamResp) ProtoMessage() {}
 
-func (x *SetEventStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *DelTeamResp) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -860,35 +1027,37 @@ func (x *SetEventStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetEventStatusRequest.ProtoReflect.Descriptor instead.
-func (*SetEventStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelTeamResp.ProtoReflect.Descriptor instead.
+func (*DelTeamResp) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SetEventStatusRequest) GetEventTag() string {
+func (x *DelTeamResp) GetMessage() string {
 	if x != nil {
-		return x.EventTag
+		return x.Message
 	}
 	return ""
 }
 
-func (x *SetEventStatusRequest) GetStatus() int32 {
+func (x *DelTeamResp) GetErrorMessage() string {
 	if x != nil {
-		return x.Status
+		return x.ErrorMessage
 	}
-	return 0
+	return ""
 }
 
-type EventStatusStore struct {
+type UpdateTeamPassRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	EncryptedPass string `protobuf:"bytes,1,opt,name=encryptedPass,proto3" json:"encryptedPass,omitempty"`
+	TeamID        string `protobuf:"bytes,2,opt,name=teamID,proto3" json:"teamID,omitempty"`
+	EventID       int32  `protobuf:"varint,3,opt,name=eventID,proto3" json:"eventID,omitempty"`
 }
 
-func (x *EventStatusStore) Reset() {
-	*x = EventStatusStore{}
+func (x *UpdateTeamPassRequest) Reset() {
+	*x = UpdateTeamPassRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -896,13 +1065,13 @@ func (x *EventStatusStore) Reset() {
 	}
 }
 
-func (x *EventStatusStore) String() string {
+func (x *UpdateTeamPassRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventStatusStore) ProtoMessage() {}
+func (*UpdateTeamPassRequest) ProtoMessage() {}
 
-func (x *EventStatusStore) ProtoReflect() protoreflect.Message {
+func (x *UpdateTeamPassRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,41 +1083,42 @@ func (x *EventStatusStore) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventStatusStore.ProtoReflect.Descriptor instead.
-func (*EventStatusStore) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateTeamPassRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamPassRequest) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *EventStatusStore) GetStatus() int32 {
+func (x *UpdateTeamPassRequest) GetEncryptedPass() string {
 	if x != nil {
-		return x.Status
+		return x.EncryptedPass
+	}
+	return ""
+}
+
+func (x *UpdateTeamPassRequest) GetTeamID() string {
+	if x != nil {
+		return x.TeamID
+	}
+	return ""
+}
+
+func (x *UpdateTeamPassRequest) GetEventID() int32 {
+	if x != nil {
+		return x.EventID
 	}
 	return 0
 }
 
-type AddEventRequest struct {
+type GetEventIDReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name               string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Tag                string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	Frontends          string `protobuf:"bytes,3,opt,name=frontends,proto3" json:"frontends,omitempty"`
-	Exercises          string `protobuf:"bytes,4,opt,name=exercises,proto3" json:"exercises,omitempty"`
-	Available          int32  `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
-	Capacity           int32  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	StartTime          string `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	ExpectedFinishTime string `protobuf:"bytes,8,opt,name=expectedFinishTime,proto3" json:"expectedFinishTime,omitempty"`
-	FinishedAt         string `protobuf:"bytes,9,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
-	Status             int32  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedBy          string `protobuf:"bytes,11,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
-	OnlyVPN            bool   `protobuf:"varint,12,opt,name=onlyVPN,proto3" json:"onlyVPN,omitempty"`
-	SecretKey          string `protobuf:"bytes,13,opt,name=secretKey,proto3" json:"secretKey,omitempty"`
-	DisabledExercises  string `protobuf:"bytes,14,opt,name=disabledExercises,proto3" json:"disabledExercises,omitempty"`
+	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
 }
 
-func (x *AddEventRequest) Reset() {
-	*x = AddEventRequest{}
+func (x *GetEventIDReq) Reset() {
+	*x = GetEventIDReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -956,13 +1126,13 @@ func (x *AddEventRequest) Reset() {
 	}
 }
 
-func (x *AddEventRequest) String() string {
+func (x *GetEventIDReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEventRequest) ProtoMessage() {}
+func (*GetEventIDReq) ProtoMessage() {}
 
-func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
+func (x *GetEventIDReq) ProtoReflect() protoreflect.Message {
 	mi := &file_store_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -974,138 +1144,1620 @@ func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
-func (*AddEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEventIDReq.ProtoReflect.Descriptor instead.
+func (*GetEventIDReq) Descriptor() ([]byte, []int) {
 	return file_store_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *AddEventRequest) GetName() string {
+func (x *GetEventIDReq) GetEventTag() string {
 	if x != nil {
-		return x.Name
+		return x.EventTag
 	}
 	return ""
 }
 
-func (x *AddEventRequest) GetTag() string {
-	if x != nil {
-		return x.Tag
-	}
-	return ""
-}
+type GetEventIDResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventID int32 `protobuf:"varint,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+}
+
+func (x *GetEventIDResp) Reset() {
+	*x = GetEventIDResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventIDResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventIDResp) ProtoMessage() {}
+
+func (x *GetEventIDResp) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventIDResp.ProtoReflect.Descriptor instead.
+func (*GetEventIDResp) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetEventIDResp) GetEventID() int32 {
+	if x != nil {
+		return x.EventID
+	}
+	return 0
+}
+
+type GetTimeSeriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timeseries map[string]int32 `protobuf:"bytes,1,rep,name=timeseries,proto3" json:"timeseries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetTimeSeriesResponse) Reset() {
+	*x = GetTimeSeriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeSeriesResponse) ProtoMessage() {}
+
+func (x *GetTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTimeSeriesResponse) GetTimeseries() map[string]int32 {
+	if x != nil {
+		return x.Timeseries
+	}
+	return nil
+}
+
+type GetUsageTimeSeriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from and to are in "2006-01-02 15:04:05" format,
+	// empty means from the earliest start and to the latest finish of events
+	From        string  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To          string  `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Granularity string  `protobuf:"bytes,3,opt,name=granularity,proto3" json:"granularity,omitempty"` // hour, day, week or month, defaults to day
+	Status      []int32 `protobuf:"varint,4,rep,packed,name=status,proto3" json:"status,omitempty"`   // empty means every status except closed
+	GroupBy     string  `protobuf:"bytes,5,opt,name=groupBy,proto3" json:"groupBy,omitempty"`         // createdBy or event, empty means no grouping
+}
+
+func (x *GetUsageTimeSeriesRequest) Reset() {
+	*x = GetUsageTimeSeriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageTimeSeriesRequest) ProtoMessage() {}
+
+func (x *GetUsageTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetUsageTimeSeriesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetUsageTimeSeriesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetUsageTimeSeriesRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetUsageTimeSeriesRequest) GetStatus() []int32 {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetUsageTimeSeriesRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+type GetUsageTimeSeriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points       []*GetUsageTimeSeriesResponse_Point `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"` // ordered by time and group
+	ErrorMessage string                              `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *GetUsageTimeSeriesResponse) Reset() {
+	*x = GetUsageTimeSeriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageTimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageTimeSeriesResponse) ProtoMessage() {}
+
+func (x *GetUsageTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetUsageTimeSeriesResponse) GetPoints() []*GetUsageTimeSeriesResponse_Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetUsageTimeSeriesResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type CheckBookingCapacityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// startTime and expectedFinishTime are in "2006-01-02 15:04:05" format
+	StartTime          string `protobuf:"bytes,1,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	ExpectedFinishTime string `protobuf:"bytes,2,opt,name=expectedFinishTime,proto3" json:"expectedFinishTime,omitempty"`
+	Capacity           int32  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+}
+
+func (x *CheckBookingCapacityRequest) Reset() {
+	*x = CheckBookingCapacityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckBookingCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBookingCapacityRequest) ProtoMessage() {}
+
+func (x *CheckBookingCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBookingCapacityRequest.ProtoReflect.Descriptor instead.
+func (*CheckBookingCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CheckBookingCapacityRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CheckBookingCapacityRequest) GetExpectedFinishTime() string {
+	if x != nil {
+		return x.ExpectedFinishTime
+	}
+	return ""
+}
+
+func (x *CheckBookingCapacityRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type CheckBookingCapacityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeakLoad     int32  `protobuf:"varint,1,opt,name=peakLoad,proto3" json:"peakLoad,omitempty"` // highest projected VMs on a day of the booking, including the booking
+	PeakDay      string `protobuf:"bytes,2,opt,name=peakDay,proto3" json:"peakDay,omitempty"`
+	Limit        int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`            // limit on the peak day, zero when there is no limit
+	Fits         bool   `protobuf:"varint,4,opt,name=fits,proto3" json:"fits,omitempty"`              // false when projected VMs exceed the limit on any day
+	ExceededDay  string `protobuf:"bytes,5,opt,name=exceededDay,proto3" json:"exceededDay,omitempty"` // first day which exceeds the limit
+	ErrorMessage string `protobuf:"bytes,6,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *CheckBookingCapacityResponse) Reset() {
+	*x = CheckBookingCapacityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckBookingCapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBookingCapacityResponse) ProtoMessage() {}
+
+func (x *CheckBookingCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBookingCapacityResponse.ProtoReflect.Descriptor instead.
+func (*CheckBookingCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CheckBookingCapacityResponse) GetPeakLoad() int32 {
+	if x != nil {
+		return x.PeakLoad
+	}
+	return 0
+}
+
+func (x *CheckBookingCapacityResponse) GetPeakDay() string {
+	if x != nil {
+		return x.PeakDay
+	}
+	return ""
+}
+
+func (x *CheckBookingCapacityResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CheckBookingCapacityResponse) GetFits() bool {
+	if x != nil {
+		return x.Fits
+	}
+	return false
+}
+
+func (x *CheckBookingCapacityResponse) GetExceededDay() string {
+	if x != nil {
+		return x.ExceededDay
+	}
+	return ""
+}
+
+func (x *CheckBookingCapacityResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetEventStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+}
+
+func (x *GetEventStatusRequest) Reset() {
+	*x = GetEventStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventStatusRequest) ProtoMessage() {}
+
+func (x *GetEventStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetEventStatusRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetEventStatusRequest) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+type GetEventStatusHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes      []*GetEventStatusHistoryResponse_Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"` // ordered by time of the change
+	ErrorMessage string                                  `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *GetEventStatusHistoryResponse) Reset() {
+	*x = GetEventStatusHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventStatusHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventStatusHistoryResponse) ProtoMessage() {}
+
+func (x *GetEventStatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventStatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetEventStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetEventStatusHistoryResponse) GetChanges() []*GetEventStatusHistoryResponse_Change {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *GetEventStatusHistoryResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetEventByTagReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Status   int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetEventByTagReq) Reset() {
+	*x = GetEventByTagReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventByTagReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventByTagReq) ProtoMessage() {}
+
+func (x *GetEventByTagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventByTagReq.ProtoReflect.Descriptor instead.
+func (*GetEventByTagReq) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetEventByTagReq) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+func (x *GetEventByTagReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type GetEventByTagResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsExist bool `protobuf:"varint,1,opt,name=isExist,proto3" json:"isExist,omitempty"`
+}
+
+func (x *GetEventByTagResp) Reset() {
+	*x = GetEventByTagResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventByTagResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventByTagResp) ProtoMessage() {}
+
+func (x *GetEventByTagResp) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventByTagResp.ProtoReflect.Descriptor instead.
+func (*GetEventByTagResp) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetEventByTagResp) GetIsExist() bool {
+	if x != nil {
+		return x.IsExist
+	}
+	return false
+}
+
+type DropEventReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag    string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Status int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DropEventReq) Reset() {
+	*x = DropEventReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropEventReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropEventReq) ProtoMessage() {}
+
+func (x *DropEventReq) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropEventReq.ProtoReflect.Descriptor instead.
+func (*DropEventReq) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DropEventReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *DropEventReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type DropEventResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsDropped bool `protobuf:"varint,1,opt,name=isDropped,proto3" json:"isDropped,omitempty"`
+}
+
+func (x *DropEventResp) Reset() {
+	*x = DropEventResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropEventResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropEventResp) ProtoMessage() {}
+
+func (x *DropEventResp) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropEventResp.ProtoReflect.Descriptor instead.
+func (*DropEventResp) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DropEventResp) GetIsDropped() bool {
+	if x != nil {
+		return x.IsDropped
+	}
+	return false
+}
+
+type GetEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// this will indicate that whether all events requested or some by status
+	// status 0  > Running Events (which set as Running)
+	// status 1  > Suspended Events (which set as Suspended)
+	// status 2  > Booked Events (which are booked by client)
+	// status 3  > Closed Events (which are finished and closed )
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRequest) ProtoMessage() {}
+
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetEventRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type GetEventByUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	User   string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *GetEventByUserReq) Reset() {
+	*x = GetEventByUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventByUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventByUserReq) ProtoMessage() {}
+
+func (x *GetEventByUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventByUserReq.ProtoReflect.Descriptor instead.
+func (*GetEventByUserReq) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetEventByUserReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetEventByUserReq) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type SetEventStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Status   int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SetEventStatusRequest) Reset() {
+	*x = SetEventStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetEventStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEventStatusRequest) ProtoMessage() {}
+
+func (x *SetEventStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEventStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetEventStatusRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetEventStatusRequest) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+func (x *SetEventStatusRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type EventStatusStore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *EventStatusStore) Reset() {
+	*x = EventStatusStore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventStatusStore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStatusStore) ProtoMessage() {}
+
+func (x *EventStatusStore) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStatusStore.ProtoReflect.Descriptor instead.
+func (*EventStatusStore) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EventStatusStore) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type AddEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name               string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tag                string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Frontends          string `protobuf:"bytes,3,opt,name=frontends,proto3" json:"frontends,omitempty"`
+	Exercises          string `protobuf:"bytes,4,opt,name=exercises,proto3" json:"exercises,omitempty"`
+	Available          int32  `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	Capacity           int32  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	StartTime          string `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	ExpectedFinishTime string `protobuf:"bytes,8,opt,name=expectedFinishTime,proto3" json:"expectedFinishTime,omitempty"`
+	FinishedAt         string `protobuf:"bytes,9,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	Status             int32  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedBy          string `protobuf:"bytes,11,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	OnlyVPN            bool   `protobuf:"varint,12,opt,name=onlyVPN,proto3" json:"onlyVPN,omitempty"`
+	SecretKey          string `protobuf:"bytes,13,opt,name=secretKey,proto3" json:"secretKey,omitempty"`
+	DisabledExercises  string `protobuf:"bytes,14,opt,name=disabledExercises,proto3" json:"disabledExercises,omitempty"`
+}
+
+func (x *AddEventRequest) Reset() {
+	*x = AddEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEventRequest) ProtoMessage() {}
+
+func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
+func (*AddEventRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AddEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddEventRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
 
 func (x *AddEventRequest) GetFrontends() string {
 	if x != nil {
-		return x.Frontends
+		return x.Frontends
+	}
+	return ""
+}
+
+func (x *AddEventRequest) GetExercises() string {
+	if x != nil {
+		return x.Exercises
+	}
+	return ""
+}
+
+func (x *AddEventRequest) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *AddEventRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *AddEventRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *AddEventRequest) GetExpectedFinishTime() string {
+	if x != nil {
+		return x.ExpectedFinishTime
+	}
+	return ""
+}
+
+func (x *AddEventRequest) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *AddEventRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AddEventRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AddEventRequest) GetOnlyVPN() bool {
+	if x != nil {
+		return x.OnlyVPN
+	}
+	return false
+}
+
+func (x *AddEventRequest) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *AddEventRequest) GetDisabledExercises() string {
+	if x != nil {
+		return x.DisabledExercises
+	}
+	return ""
+}
+
+type AddTeamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventTag string `protobuf:"bytes,2,opt,name=event_tag,json=eventTag,proto3" json:"event_tag,omitempty"`
+	Email    string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Name     string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *AddTeamRequest) Reset() {
+	*x = AddTeamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamRequest) ProtoMessage() {}
+
+func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AddTeamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddTeamRequest) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+func (x *AddTeamRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddTeamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddTeamRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type InsertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message      string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *InsertResponse) Reset() {
+	*x = InsertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertResponse) ProtoMessage() {}
+
+func (x *InsertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertResponse.ProtoReflect.Descriptor instead.
+func (*InsertResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *InsertResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *AddEventRequest) GetExercises() string {
+func (x *InsertResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.Exercises
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events       []*GetEventResponse_Events `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	ErrorMessage string                     `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *GetEventResponse) Reset() {
+	*x = GetEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventResponse) ProtoMessage() {}
+
+func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
+func (*GetEventResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetEventResponse) GetEvents() []*GetEventResponse_Events {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetEventResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetEventTeamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+}
+
+func (x *GetEventTeamsRequest) Reset() {
+	*x = GetEventTeamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventTeamsRequest) ProtoMessage() {}
+
+func (x *GetEventTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventTeamsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetEventTeamsRequest) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+type GetEventTeamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Teams        []*GetEventTeamsResponse_Teams `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	ErrorMessage string                         `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *GetEventTeamsResponse) Reset() {
+	*x = GetEventTeamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventTeamsResponse) ProtoMessage() {}
+
+func (x *GetEventTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventTeamsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetEventTeamsResponse) GetTeams() []*GetEventTeamsResponse_Teams {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *GetEventTeamsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ListInactiveTeamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	// teams which have not accessed the event for this long, e.g. 30m or 2h,
+	// defaults to inactive-for of the inactive teams policy
+	InactiveFor string `protobuf:"bytes,2,opt,name=inactiveFor,proto3" json:"inactiveFor,omitempty"`
+}
+
+func (x *ListInactiveTeamsRequest) Reset() {
+	*x = ListInactiveTeamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInactiveTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInactiveTeamsRequest) ProtoMessage() {}
+
+func (x *ListInactiveTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInactiveTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListInactiveTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListInactiveTeamsRequest) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
 	}
 	return ""
 }
 
-func (x *AddEventRequest) GetAvailable() int32 {
-	if x != nil {
-		return x.Available
+func (x *ListInactiveTeamsRequest) GetInactiveFor() string {
+	if x != nil {
+		return x.InactiveFor
+	}
+	return ""
+}
+
+type ListInactiveTeamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Teams        []*ListInactiveTeamsResponse_Team `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"` // ordered by last access, the longest inactive first
+	ErrorMessage string                            `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *ListInactiveTeamsResponse) Reset() {
+	*x = ListInactiveTeamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInactiveTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInactiveTeamsResponse) ProtoMessage() {}
+
+func (x *ListInactiveTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInactiveTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListInactiveTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListInactiveTeamsResponse) GetTeams() []*ListInactiveTeamsResponse_Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *ListInactiveTeamsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type UpdateEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldTag     string `protobuf:"bytes,1,opt,name=oldTag,proto3" json:"oldTag,omitempty"`
+	NewTag     string `protobuf:"bytes,2,opt,name=newTag,proto3" json:"newTag,omitempty"`
+	FinishedAt string `protobuf:"bytes,3,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AddEventRequest) GetCapacity() int32 {
-	if x != nil {
-		return x.Capacity
-	}
-	return 0
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *AddEventRequest) GetStartTime() string {
+func (x *UpdateEventRequest) GetOldTag() string {
 	if x != nil {
-		return x.StartTime
+		return x.OldTag
 	}
 	return ""
 }
 
-func (x *AddEventRequest) GetExpectedFinishTime() string {
+func (x *UpdateEventRequest) GetNewTag() string {
 	if x != nil {
-		return x.ExpectedFinishTime
+		return x.NewTag
 	}
 	return ""
 }
 
-func (x *AddEventRequest) GetFinishedAt() string {
+func (x *UpdateEventRequest) GetFinishedAt() string {
 	if x != nil {
 		return x.FinishedAt
 	}
 	return ""
 }
 
-func (x *AddEventRequest) GetStatus() int32 {
-	if x != nil {
-		return x.Status
+type UpdateTeamSolvedChallengeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamId      string `protobuf:"bytes,1,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Tag         string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	CompletedAt string `protobuf:"bytes,3,opt,name=completedAt,proto3" json:"completedAt,omitempty"`
+}
+
+func (x *UpdateTeamSolvedChallengeRequest) Reset() {
+	*x = UpdateTeamSolvedChallengeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AddEventRequest) GetCreatedBy() string {
-	if x != nil {
-		return x.CreatedBy
+func (x *UpdateTeamSolvedChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamSolvedChallengeRequest) ProtoMessage() {}
+
+func (x *UpdateTeamSolvedChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AddEventRequest) GetOnlyVPN() bool {
+// Deprecated: Use UpdateTeamSolvedChallengeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamSolvedChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateTeamSolvedChallengeRequest) GetTeamId() string {
 	if x != nil {
-		return x.OnlyVPN
+		return x.TeamId
 	}
-	return false
+	return ""
 }
 
-func (x *AddEventRequest) GetSecretKey() string {
+func (x *UpdateTeamSolvedChallengeRequest) GetTag() string {
 	if x != nil {
-		return x.SecretKey
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *AddEventRequest) GetDisabledExercises() string {
+func (x *UpdateTeamSolvedChallengeRequest) GetCompletedAt() string {
 	if x != nil {
-		return x.DisabledExercises
+		return x.CompletedAt
 	}
 	return ""
 }
 
-type AddTeamRequest struct {
+type UpdateTeamLastAccessRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	EventTag string `protobuf:"bytes,2,opt,name=event_tag,json=eventTag,proto3" json:"event_tag,omitempty"`
-	Email    string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Name     string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	TeamId   string `protobuf:"bytes,1,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	AccessAt string `protobuf:"bytes,2,opt,name=accessAt,proto3" json:"accessAt,omitempty"`
 }
 
-func (x *AddTeamRequest) Reset() {
-	*x = AddTeamRequest{}
+func (x *UpdateTeamLastAccessRequest) Reset() {
+	*x = UpdateTeamLastAccessRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_proto_msgTypes[19]
+		mi := &file_store_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddTeamRequest) String() string {
+func (x *UpdateTeamLastAccessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTeamRequest) ProtoMessage() {}
+func (*UpdateTeamLastAccessRequest) ProtoMessage() {}
 
-func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[19]
+func (x *UpdateTeamLastAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,47 +2768,26 @@ func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
-func (*AddTeamRequest) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *AddTeamRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *AddTeamRequest) GetEventTag() string {
-	if x != nil {
-		return x.EventTag
-	}
-	return ""
-}
-
-func (x *AddTeamRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// Deprecated: Use UpdateTeamLastAccessRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamLastAccessRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *AddTeamRequest) GetName() string {
+func (x *UpdateTeamLastAccessRequest) GetTeamId() string {
 	if x != nil {
-		return x.Name
+		return x.TeamId
 	}
 	return ""
 }
 
-func (x *AddTeamRequest) GetPassword() string {
+func (x *UpdateTeamLastAccessRequest) GetAccessAt() string {
 	if x != nil {
-		return x.Password
+		return x.AccessAt
 	}
 	return ""
 }
 
-type InsertResponse struct {
+type UpdateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1165,23 +2796,23 @@ type InsertResponse struct {
 	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
 }
 
-func (x *InsertResponse) Reset() {
-	*x = InsertResponse{}
+func (x *UpdateResponse) Reset() {
+	*x = UpdateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_proto_msgTypes[20]
+		mi := &file_store_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InsertResponse) String() string {
+func (x *UpdateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertResponse) ProtoMessage() {}
+func (*UpdateResponse) ProtoMessage() {}
 
-func (x *InsertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[20]
+func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,51 +2823,57 @@ func (x *InsertResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertResponse.ProtoReflect.Descriptor instead.
-func (*InsertResponse) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *InsertResponse) GetMessage() string {
+func (x *UpdateResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *InsertResponse) GetErrorMessage() string {
+func (x *UpdateResponse) GetErrorMessage() string {
 	if x != nil {
 		return x.ErrorMessage
 	}
 	return ""
 }
 
-type GetEventResponse struct {
+type QueryAuditLogResponse_Entry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Events       []*GetEventResponse_Events `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	ErrorMessage string                     `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	CreatedAt    string `protobuf:"bytes,1,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Method       string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Caller       string `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	EventTag     string `protobuf:"bytes,4,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	TeamId       string `protobuf:"bytes,5,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Request      string `protobuf:"bytes,6,opt,name=request,proto3" json:"request,omitempty"` // request in JSON, secrets are redacted
+	Code         string `protobuf:"bytes,7,opt,name=code,proto3" json:"code,omitempty"`
+	ErrorMessage string `protobuf:"bytes,8,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
 }
 
-func (x *GetEventResponse) Reset() {
-	*x = GetEventResponse{}
+func (x *QueryAuditLogResponse_Entry) Reset() {
+	*x = QueryAuditLogResponse_Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_proto_msgTypes[21]
+		mi := &file_store_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetEventResponse) String() string {
+func (x *QueryAuditLogResponse_Entry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventResponse) ProtoMessage() {}
+func (*QueryAuditLogResponse_Entry) ProtoMessage() {}
 
-func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[21]
+func (x *QueryAuditLogResponse_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1247,50 +2884,93 @@ func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
-func (*GetEventResponse) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use QueryAuditLogResponse_Entry.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogResponse_Entry) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{1, 0}
 }
 
-func (x *GetEventResponse) GetEvents() []*GetEventResponse_Events {
+func (x *QueryAuditLogResponse_Entry) GetCreatedAt() string {
 	if x != nil {
-		return x.Events
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *GetEventResponse) GetErrorMessage() string {
+func (x *QueryAuditLogResponse_Entry) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *QueryAuditLogResponse_Entry) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *QueryAuditLogResponse_Entry) GetEventTag() string {
+	if x != nil {
+		return x.EventTag
+	}
+	return ""
+}
+
+func (x *QueryAuditLogResponse_Entry) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *QueryAuditLogResponse_Entry) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *QueryAuditLogResponse_Entry) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *QueryAuditLogResponse_Entry) GetErrorMessage() string {
 	if x != nil {
 		return x.ErrorMessage
 	}
 	return ""
 }
 
-type GetEventTeamsRequest struct {
+type EraseTeamDataResponse_Team struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	EventTag string `protobuf:"bytes,1,opt,name=eventTag,proto3" json:"eventTag,omitempty"`
+	Id       string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetEventTeamsRequest) Reset() {
-	*x = GetEventTeamsRequest{}
+func (x *EraseTeamDataResponse_Team) Reset() {
+	*x = EraseTeamDataResponse_Team{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_proto_msgTypes[22]
+		mi := &file_store_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetEventTeamsRequest) String() string {
+func (x *EraseTeamDataResponse_Team) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventTeamsRequest) ProtoMessage() {}
+func (*EraseTeamDataResponse_Team) ProtoMessage() {}
 
-func (x *GetEventTeamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[22]
+func (x *EraseTeamDataResponse_Team) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1301,44 +2981,53 @@ func (x *GetEventTeamsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventTeamsRequest.ProtoReflect.Descriptor instead.
-func (*GetEventTeamsRequest) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use EraseTeamDataResponse_Team.ProtoReflect.Descriptor instead.
+func (*EraseTeamDataResponse_Team) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{7, 0}
 }
 
-func (x *GetEventTeamsRequest) GetEventTag() string {
+func (x *EraseTeamDataResponse_Team) GetEventTag() string {
 	if x != nil {
 		return x.EventTag
 	}
 	return ""
 }
 
-type GetEventTeamsResponse struct {
+func (x *EraseTeamDataResponse_Team) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetEventStatsResponse_Challenge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Teams        []*GetEventTeamsResponse_Teams `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
-	ErrorMessage string                         `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	Tag                string  `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Solves             int32   `protobuf:"varint,2,opt,name=solves,proto3" json:"solves,omitempty"`
+	SolvedRatio        float64 `protobuf:"fixed64,3,opt,name=solvedRatio,proto3" json:"solvedRatio,omitempty"`              // fraction of teams which solved the challenge
+	MedianSolveSeconds int64   `protobuf:"varint,4,opt,name=medianSolveSeconds,proto3" json:"medianSolveSeconds,omitempty"` // median time to solve, zero when nobody solved it
 }
 
-func (x *GetEventTeamsResponse) Reset() {
-	*x = GetEventTeamsResponse{}
+func (x *GetEventStatsResponse_Challenge) Reset() {
+	*x = GetEventStatsResponse_Challenge{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_proto_msgTypes[23]
+		mi := &file_store_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetEventTeamsResponse) String() string {
+func (x *GetEventStatsResponse_Challenge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventTeamsResponse) ProtoMessage() {}
+func (*GetEventStatsResponse_Challenge) ProtoMessage() {}
 
-func (x *GetEventTeamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[23]
+func (x *GetEventStatsResponse_Challenge) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1349,52 +3038,66 @@ func (x *GetEventTeamsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventTeamsResponse.ProtoReflect.Descriptor instead.
-func (*GetEventTeamsResponse) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetEventStatsResponse_Challenge.ProtoReflect.Descriptor instead.
+func (*GetEventStatsResponse_Challenge) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *GetEventStatsResponse_Challenge) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetEventStatsResponse_Challenge) GetSolves() int32 {
+	if x != nil {
+		return x.Solves
+	}
+	return 0
 }
 
-func (x *GetEventTeamsResponse) GetTeams() []*GetEventTeamsResponse_Teams {
+func (x *GetEventStatsResponse_Challenge) GetSolvedRatio() float64 {
 	if x != nil {
-		return x.Teams
+		return x.SolvedRatio
 	}
-	return nil
+	return 0
 }
 
-func (x *GetEventTeamsResponse) GetErrorMessage() string {
+func (x *GetEventStatsResponse_Challenge) GetMedianSolveSeconds() int64 {
 	if x != nil {
-		return x.ErrorMessage
+		return x.MedianSolveSeconds
 	}
-	return ""
+	return 0
 }
 
-type UpdateEventRequest struct {
+type GetSolveTimelineResponse_Bucket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OldTag     string `protobuf:"bytes,1,opt,name=oldTag,proto3" json:"oldTag,omitempty"`
-	NewTag     string `protobuf:"bytes,2,opt,name=newTag,proto3" json:"newTag,omitempty"`
-	FinishedAt string `protobuf:"bytes,3,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	Time   string           `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`                                                                                              // start of the interval
+	Solves map[string]int32 `protobuf:"bytes,2,rep,name=solves,proto3" json:"solves,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // solves of challenges in the interval
+	Total  int32            `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *UpdateEventRequest) Reset() {
-	*x = UpdateEventRequest{}
+func (x *GetSolveTimelineResponse_Bucket) Reset() {
+	*x = GetSolveTimelineResponse_Bucket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_proto_msgTypes[24]
+		mi := &file_store_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateEventRequest) String() string {
+func (x *GetSolveTimelineResponse_Bucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEventRequest) ProtoMessage() {}
+func (*GetSolveTimelineResponse_Bucket) ProtoMessage() {}
 
-func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[24]
+func (x *GetSolveTimelineResponse_Bucket) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1405,59 +3108,60 @@ func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetSolveTimelineResponse_Bucket.ProtoReflect.Descriptor instead.
+func (*GetSolveTimelineResponse_Bucket) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{11, 0}
 }
 
-func (x *UpdateEventRequest) GetOldTag() string {
+func (x *GetSolveTimelineResponse_Bucket) GetTime() string {
 	if x != nil {
-		return x.OldTag
+		return x.Time
 	}
 	return ""
 }
 
-func (x *UpdateEventRequest) GetNewTag() string {
+func (x *GetSolveTimelineResponse_Bucket) GetSolves() map[string]int32 {
 	if x != nil {
-		return x.NewTag
+		return x.Solves
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateEventRequest) GetFinishedAt() string {
+func (x *GetSolveTimelineResponse_Bucket) GetTotal() int32 {
 	if x != nil {
-		return x.FinishedAt
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type UpdateTeamSolvedChallengeRequest struct {
+type GetSolveTimelineResponse_Team struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TeamId      string `protobuf:"bytes,1,opt,name=teamId,proto3" json:"teamId,omitempty"`
-	Tag         string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	CompletedAt string `protobuf:"bytes,3,opt,name=completedAt,proto3" json:"completedAt,omitempty"`
+	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Points     int32   `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	Cumulative []int32 `protobuf:"varint,4,rep,packed,name=cumulative,proto3" json:"cumulative,omitempty"` // points at the end of each interval
 }
 
-func (x *UpdateTeamSolvedChallengeRequest) Reset() {
-	*x = UpdateTeamSolvedChallengeRequest{}
+func (x *GetSolveTimelineResponse_Team) Reset() {
+	*x = GetSolveTimelineResponse_Team{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_proto_msgTypes[25]
+		mi := &file_store_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateTeamSolvedChallengeRequest) String() string {
+func (x *GetSolveTimelineResponse_Team) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTeamSolvedChallengeRequest) ProtoMessage() {}
+func (*GetSolveTimelineResponse_Team) ProtoMessage() {}
 
-func (x *UpdateTeamSolvedChallengeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[25]
+func (x *GetSolveTimelineResponse_Team) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1468,58 +3172,66 @@ func (x *UpdateTeamSolvedChallengeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTeamSolvedChallengeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTeamSolvedChallengeRequest) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetSolveTimelineResponse_Team.ProtoReflect.Descriptor instead.
+func (*GetSolveTimelineResponse_Team) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{11, 1}
 }
 
-func (x *UpdateTeamSolvedChallengeRequest) GetTeamId() string {
+func (x *GetSolveTimelineResponse_Team) GetId() string {
 	if x != nil {
-		return x.TeamId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateTeamSolvedChallengeRequest) GetTag() string {
+func (x *GetSolveTimelineResponse_Team) GetName() string {
 	if x != nil {
-		return x.Tag
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateTeamSolvedChallengeRequest) GetCompletedAt() string {
+func (x *GetSolveTimelineResponse_Team) GetPoints() int32 {
 	if x != nil {
-		return x.CompletedAt
+		return x.Points
 	}
-	return ""
+	return 0
 }
 
-type UpdateTeamLastAccessRequest struct {
+func (x *GetSolveTimelineResponse_Team) GetCumulative() []int32 {
+	if x != nil {
+		return x.Cumulative
+	}
+	return nil
+}
+
+type GetUsageTimeSeriesResponse_Point struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TeamId   string `protobuf:"bytes,1,opt,name=teamId,proto3" json:"teamId,omitempty"`
-	AccessAt string `protobuf:"bytes,2,opt,name=accessAt,proto3" json:"accessAt,omitempty"`
+	Time  string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`   // start of the interval
+	Group string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"` // creator or tag of the event, empty when not grouped
+	Vms   int32  `protobuf:"varint,3,opt,name=vms,proto3" json:"vms,omitempty"`    // available VMs and teams of events running in the interval
 }
 
-func (x *UpdateTeamLastAccessRequest) Reset() {
-	*x = UpdateTeamLastAccessRequest{}
+func (x *GetUsageTimeSeriesResponse_Point) Reset() {
+	*x = GetUsageTimeSeriesResponse_Point{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_proto_msgTypes[26]
+		mi := &file_store_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateTeamLastAccessRequest) String() string {
+func (x *GetUsageTimeSeriesResponse_Point) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTeamLastAccessRequest) ProtoMessage() {}
+func (*GetUsageTimeSeriesResponse_Point) ProtoMessage() {}
 
-func (x *UpdateTeamLastAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[26]
+func (x *GetUsageTimeSeriesResponse_Point) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1530,51 +3242,61 @@ func (x *UpdateTeamLastAccessRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTeamLastAccessRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTeamLastAccessRequest) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetUsageTimeSeriesResponse_Point.ProtoReflect.Descriptor instead.
+func (*GetUsageTimeSeriesResponse_Point) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{22, 0}
 }
 
-func (x *UpdateTeamLastAccessRequest) GetTeamId() string {
+func (x *GetUsageTimeSeriesResponse_Point) GetTime() string {
 	if x != nil {
-		return x.TeamId
+		return x.Time
 	}
 	return ""
 }
 
-func (x *UpdateTeamLastAccessRequest) GetAccessAt() string {
+func (x *GetUsageTimeSeriesResponse_Point) GetGroup() string {
 	if x != nil {
-		return x.AccessAt
+		return x.Group
 	}
 	return ""
 }
 
-type UpdateResponse struct {
+func (x *GetUsageTimeSeriesResponse_Point) GetVms() int32 {
+	if x != nil {
+		return x.Vms
+	}
+	return 0
+}
+
+type GetEventStatusHistoryResponse_Change struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message      string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
-	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	Status         int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	PreviousStatus int32  `protobuf:"varint,2,opt,name=previousStatus,proto3" json:"previousStatus,omitempty"`
+	ChangedAt      string `protobuf:"bytes,3,opt,name=changedAt,proto3" json:"changedAt,omitempty"`
+	Source         string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"` // api or scheduler
+	Reason         string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *UpdateResponse) Reset() {
-	*x = UpdateResponse{}
+func (x *GetEventStatusHistoryResponse_Change) Reset() {
+	*x = GetEventStatusHistoryResponse_Change{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_proto_msgTypes[27]
+		mi := &file_store_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateResponse) String() string {
+func (x *GetEventStatusHistoryResponse_Change) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateResponse) ProtoMessage() {}
+func (*GetEventStatusHistoryResponse_Change) ProtoMessage() {}
 
-func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[27]
+func (x *GetEventStatusHistoryResponse_Change) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1585,21 +3307,42 @@ func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
-func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetEventStatusHistoryResponse_Change.ProtoReflect.Descriptor instead.
+func (*GetEventStatusHistoryResponse_Change) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{26, 0}
 }
 
-func (x *UpdateResponse) GetMessage() string {
+func (x *GetEventStatusHistoryResponse_Change) GetStatus() int32 {
 	if x != nil {
-		return x.Message
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetEventStatusHistoryResponse_Change) GetPreviousStatus() int32 {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return 0
+}
+
+func (x *GetEventStatusHistoryResponse_Change) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
 	}
 	return ""
 }
 
-func (x *UpdateResponse) GetErrorMessage() string {
+func (x *GetEventStatusHistoryResponse_Change) GetSource() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GetEventStatusHistoryResponse_Change) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
@@ -1628,7 +3371,7 @@ type GetEventResponse_Events struct {
 func (x *GetEventResponse_Events) Reset() {
 	*x = GetEventResponse_Events{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_proto_msgTypes[29]
+		mi := &file_store_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1641,7 +3384,7 @@ func (x *GetEventResponse_Events) String() string {
 func (*GetEventResponse_Events) ProtoMessage() {}
 
 func (x *GetEventResponse_Events) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[29]
+	mi := &file_store_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1654,7 +3397,7 @@ func (x *GetEventResponse_Events) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventResponse_Events.ProtoReflect.Descriptor instead.
 func (*GetEventResponse_Events) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{21, 0}
+	return file_store_proto_rawDescGZIP(), []int{38, 0}
 }
 
 func (x *GetEventResponse_Events) GetName() string {
@@ -1772,7 +3515,7 @@ type GetEventTeamsResponse_Teams struct {
 func (x *GetEventTeamsResponse_Teams) Reset() {
 	*x = GetEventTeamsResponse_Teams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_proto_msgTypes[30]
+		mi := &file_store_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1785,7 +3528,7 @@ func (x *GetEventTeamsResponse_Teams) String() string {
 func (*GetEventTeamsResponse_Teams) ProtoMessage() {}
 
 func (x *GetEventTeamsResponse_Teams) ProtoReflect() protoreflect.Message {
-	mi := &file_store_proto_msgTypes[30]
+	mi := &file_store_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1798,7 +3541,7 @@ func (x *GetEventTeamsResponse_Teams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventTeamsResponse_Teams.ProtoReflect.Descriptor instead.
 func (*GetEventTeamsResponse_Teams) Descriptor() ([]byte, []int) {
-	return file_store_proto_rawDescGZIP(), []int{23, 0}
+	return file_store_proto_rawDescGZIP(), []int{40, 0}
 }
 
 func (x *GetEventTeamsResponse_Teams) GetId() string {
@@ -1850,284 +3593,710 @@ func (x *GetEventTeamsResponse_Teams) GetSolvedChallenges() string {
 	return ""
 }
 
+type ListInactiveTeamsResponse_Team struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LastAccess      string `protobuf:"bytes,3,opt,name=lastAccess,proto3" json:"lastAccess,omitempty"`
+	InactiveSeconds int64  `protobuf:"varint,4,opt,name=inactiveSeconds,proto3" json:"inactiveSeconds,omitempty"`
+	FlaggedAt       string `protobuf:"bytes,5,opt,name=flaggedAt,proto3" json:"flaggedAt,omitempty"` // when the policy flagged the team, empty when it is not flagged
+}
+
+func (x *ListInactiveTeamsResponse_Team) Reset() {
+	*x = ListInactiveTeamsResponse_Team{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInactiveTeamsResponse_Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInactiveTeamsResponse_Team) ProtoMessage() {}
+
+func (x *ListInactiveTeamsResponse_Team) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInactiveTeamsResponse_Team.ProtoReflect.Descriptor instead.
+func (*ListInactiveTeamsResponse_Team) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{42, 0}
+}
+
+func (x *ListInactiveTeamsResponse_Team) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListInactiveTeamsResponse_Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListInactiveTeamsResponse_Team) GetLastAccess() string {
+	if x != nil {
+		return x.LastAccess
+	}
+	return ""
+}
+
+func (x *ListInactiveTeamsResponse_Team) GetInactiveSeconds() int64 {
+	if x != nil {
+		return x.InactiveSeconds
+	}
+	return 0
+}
+
+func (x *ListInactiveTeamsResponse_Team) GetFlaggedAt() string {
+	if x != nil {
+		return x.FlaggedAt
+	}
+	return ""
+}
+
 var File_store_proto protoreflect.FileDescriptor
 
 var file_store_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x22, 0x53, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78,
-	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61,
-	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
-	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x16, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x0e, 0x0a,
-	0x0c, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a,
-	0x0e, 0x44, 0x65, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x76, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x76, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x27, 0x0a,
-	0x0b, 0x44, 0x65, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6f, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
-	0x64, 0x50, 0x61, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x6f, 0x72, 0x65, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xd7, 0x02, 0x0a, 0x15, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0xdb, 0x01, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x70, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x22, 0x6f, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x77, 0x54, 0x61, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x54, 0x61, 0x67, 0x22, 0x6b,
+	0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x14, 0x45,
+	0x72, 0x61, 0x73, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xa8, 0x01, 0x0a, 0x15, 0x45, 0x72,
+	0x61, 0x73, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x65,
+	0x54, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x1a, 0x32, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
 	0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x54, 0x61, 0x67, 0x22, 0x2a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x22, 0xa4, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0a, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x74, 0x54, 0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x7a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x22, 0xb5, 0x03, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65,
+	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x7a, 0x65,
+	0x72, 0x6f, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x7a, 0x65, 0x72, 0x6f, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x6f, 0x6c, 0x76,
+	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x0a,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x87,
+	0x01, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x6e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x6f, 0x6c, 0x76,
+	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x53, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x6f, 0x70, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x74, 0x6f, 0x70, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
 	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x33, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x22, 0x46, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71,
-	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x22, 0x38, 0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2d, 0x0a,
-	0x0d, 0x44, 0x72, 0x6f, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c,
-	0x0a, 0x09, 0x69, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x4b, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2a, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0xb7, 0x03, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x66,
-	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x65,
-	0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78,
-	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
-	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x2e, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x70,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x42, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x6c, 0x79, 0x56, 0x50, 0x4e,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x6c, 0x79, 0x56, 0x50, 0x4e, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a,
-	0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73,
-	0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0e,
-	0x41, 0x64, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x22, 0x4e, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a,
-	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x9f, 0x04, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22,
-	0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x1a, 0xae, 0x03, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x74, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a,
-	0x07, 0x6f, 0x6e, 0x6c, 0x79, 0x56, 0x50, 0x4e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x6f, 0x6e, 0x6c, 0x79, 0x56, 0x50, 0x4e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x4b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69,
-	0x73, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
-	0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x22, 0xc7, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x38, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54,
-	0x65, 0x61, 0x6d, 0x73, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdc, 0x03, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53,
+	0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61,
+	0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0xb9, 0x01, 0x0a, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x6f,
+	0x6c, 0x76, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x39, 0x0a, 0x0b, 0x53, 0x6f, 0x6c, 0x76, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x62, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x75, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0x53, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x16, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x0e, 0x0a, 0x0c, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x3e, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x76, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22,
+	0x4b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6f, 0x0a, 0x15,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x50, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x2b, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x22, 0x2a, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0xa4, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4c, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x3d,
+	0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x93, 0x01,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x42, 0x79, 0x22, 0xc6, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x43, 0x0a, 0x05, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x76, 0x6d, 0x73, 0x22, 0x87, 0x01, 0x0a,
+	0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0xc4, 0x01, 0x0a, 0x1c, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x65, 0x61, 0x6b, 0x4c,
+	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x65, 0x61, 0x6b, 0x4c,
+	0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x61, 0x6b, 0x44, 0x61, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x61, 0x6b, 0x44, 0x61, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x66, 0x69, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x63, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x44, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x44, 0x61, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x33, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x61, 0x67, 0x22, 0xa3, 0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65,
 	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
-	0xcf, 0x01, 0x0a, 0x05, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x43,
-	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x73, 0x22, 0x64, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6c, 0x64, 0x54, 0x61,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x6c, 0x64, 0x54, 0x61, 0x67, 0x12,
-	0x16, 0x0a, 0x06, 0x6e, 0x65, 0x77, 0x54, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6e, 0x65, 0x77, 0x54, 0x61, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6e, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74,
-	0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61,
-	0x6d, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x51, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x41, 0x74, 0x22, 0x4e, 0x0a, 0x0e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0xc3, 0x09, 0x0a, 0x05, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x16, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x39, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x65,
-	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09,
-	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18,
+	0x96, 0x01, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x61,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x22,
+	0x38, 0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2d, 0x0a, 0x0d, 0x44, 0x72, 0x6f,
+	0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73,
+	0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x22, 0x4b, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x2a, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb7, 0x03,
+	0x0a, 0x0f, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69,
+	0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x6c, 0x79, 0x56, 0x50, 0x4e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x6c, 0x79, 0x56, 0x50, 0x4e, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x45, 0x78,
+	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x54,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x4e, 0x0a,
+	0x0e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9f, 0x04,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0xae,
+	0x03, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12,
+	0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x6c,
+	0x79, 0x56, 0x50, 0x4e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x6c, 0x79,
+	0x56, 0x50, 0x4e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65,
+	0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x22,
+	0x32, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x61, 0x67, 0x22, 0xc7, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x73,
+	0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0xcf, 0x01, 0x0a, 0x05,
+	0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x58, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x46, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x22, 0x91, 0x02, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61,
+	0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x92, 0x01, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x69, 0x6e,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x41, 0x74, 0x22, 0x64, 0x0a, 0x12, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6c, 0x64, 0x54, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x6c, 0x64, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x77,
+	0x54, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x54, 0x61,
+	0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x6e, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x53,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x51, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x4c,
+	0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x41, 0x74, 0x22, 0x4e, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x32, 0x95, 0x17, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x50,
+	0x0a, 0x08, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0f, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x01, 0x2a,
+	0x12, 0x60, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x7d, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x50, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x16, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x64, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73,
+	0x65, 0x72, 0x7d, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x6f, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b,
+	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x61, 0x67, 0x7d, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d,
+	0x73, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x61, 0x67, 0x7d, 0x2f, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2d, 0x74, 0x65, 0x61,
+	0x6d, 0x73, 0x12, 0x6d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x89, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x7d, 0x2f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x68, 0x0a,
+	0x0d, 0x49, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x17,
 	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42,
-	0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
-	0x65, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d,
-	0x49, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x17, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79,
-	0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72,
-	0x69, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x09, 0x44, 0x72, 0x6f, 0x70,
+	0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x7d,
+	0x2f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x6c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x75, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x09, 0x44, 0x72, 0x6f, 0x70,
 	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x72,
 	0x6f, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x6f,
 	0x72, 0x65, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x12, 0x14, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x49, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x10, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x5d, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d,
-	0x53, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12,
-	0x27, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65,
-	0x61, 0x6d, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x67, 0x7d, 0x12, 0x5b, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x15,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x61, 0x67, 0x7d, 0x2f, 0x69, 0x64, 0x12, 0x70, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x1a, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x7d, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x6a, 0x0a, 0x10, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
 	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x53, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x4c,
-	0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x61, 0x73, 0x74,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x50,
-	0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65,
-	0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
-	0x65, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x54,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
-	0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
-	0x61, 0x75, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2d, 0x73, 0x65, 0x63, 0x75, 0x72,
-	0x69, 0x74, 0x79, 0x2f, 0x68, 0x61, 0x61, 0x75, 0x6b, 0x69, 0x6e, 0x73, 0x2d, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x6f, 0x6c, 0x64, 0x54, 0x61, 0x67, 0x7d, 0x2f, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x81, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x12, 0x27, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31,
+	0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x7b, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x7d, 0x2f,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7c, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x61, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x23, 0x1a, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f,
+	0x7b, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x7d, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x2d, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x1a, 0x2c, 0x2f, 0x76, 0x31,
+	0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x7d, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x7b, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x7d,
+	0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x7a, 0x0a, 0x0f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x12,
+	0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78,
+	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72,
+	0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x7d, 0x2f, 0x65, 0x78, 0x65, 0x72,
+	0x63, 0x69, 0x73, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x62, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44,
+	0x65, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x2a, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x54, 0x61, 0x67, 0x7d, 0x2f, 0x74, 0x65,
+	0x61, 0x6d, 0x73, 0x2f, 0x7b, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x7d, 0x12, 0x66, 0x0a, 0x0d,
+	0x45, 0x72, 0x61, 0x73, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x65, 0x72, 0x61, 0x73,
+	0x65, 0x3a, 0x01, 0x2a, 0x12, 0x6a, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x7d, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x62, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x19, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11,
+	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x3a, 0x01, 0x2a, 0x12, 0x6f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x7d, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6c,
+	0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x7b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x7d, 0x2f, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x2d, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x61, 0x0a, 0x0d, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2d, 0x6c, 0x6f, 0x67, 0x42, 0x36, 0x5a, 0x34,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x61, 0x75, 0x2d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f,
+	0x68, 0x61, 0x61, 0x75, 0x6b, 0x69, 0x6e, 0x73, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2142,83 +4311,142 @@ func file_store_proto_rawDescGZIP() []byte {
 	return file_store_proto_rawDescData
 }
 
-var file_store_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_store_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_store_proto_goTypes = []interface{}{
-	(*UpdateExerciseRequest)(nil),            // 0: store.UpdateExerciseRequest
-	(*UpdateExerciseResponse)(nil),           // 1: store.UpdateExerciseResponse
-	(*EmptyRequest)(nil),                     // 2: store.EmptyRequest
-	(*DelTeamRequest)(nil),                   // 3: store.DelTeamRequest
-	(*DelTeamResp)(nil),                      // 4: store.DelTeamResp
-	(*UpdateTeamPassRequest)(nil),            // 5: store.UpdateTeamPassRequest
-	(*GetEventIDReq)(nil),                    // 6: store.GetEventIDReq
-	(*GetEventIDResp)(nil),                   // 7: store.GetEventIDResp
-	(*GetTimeSeriesResponse)(nil),            // 8: store.GetTimeSeriesResponse
-	(*GetEventStatusRequest)(nil),            // 9: store.GetEventStatusRequest
-	(*GetEventByTagReq)(nil),                 // 10: store.GetEventByTagReq
-	(*GetEventByTagResp)(nil),                // 11: store.GetEventByTagResp
-	(*DropEventReq)(nil),                     // 12: store.DropEventReq
-	(*DropEventResp)(nil),                    // 13: store.DropEventResp
-	(*GetEventRequest)(nil),                  // 14: store.GetEventRequest
-	(*GetEventByUserReq)(nil),                // 15: store.GetEventByUserReq
-	(*SetEventStatusRequest)(nil),            // 16: store.SetEventStatusRequest
-	(*EventStatusStore)(nil),                 // 17: store.EventStatusStore
-	(*AddEventRequest)(nil),                  // 18: store.AddEventRequest
-	(*AddTeamRequest)(nil),                   // 19: store.AddTeamRequest
-	(*InsertResponse)(nil),                   // 20: store.InsertResponse
-	(*GetEventResponse)(nil),                 // 21: store.GetEventResponse
-	(*GetEventTeamsRequest)(nil),             // 22: store.GetEventTeamsRequest
-	(*GetEventTeamsResponse)(nil),            // 23: store.GetEventTeamsResponse
-	(*UpdateEventRequest)(nil),               // 24: store.UpdateEventRequest
-	(*UpdateTeamSolvedChallengeRequest)(nil), // 25: store.UpdateTeamSolvedChallengeRequest
-	(*UpdateTeamLastAccessRequest)(nil),      // 26: store.UpdateTeamLastAccessRequest
-	(*UpdateResponse)(nil),                   // 27: store.UpdateResponse
-	nil,                                      // 28: store.GetTimeSeriesResponse.TimeseriesEntry
-	(*GetEventResponse_Events)(nil),          // 29: store.GetEventResponse.Events
-	(*GetEventTeamsResponse_Teams)(nil),      // 30: store.GetEventTeamsResponse.Teams
+	(*QueryAuditLogRequest)(nil),                 // 0: store.QueryAuditLogRequest
+	(*QueryAuditLogResponse)(nil),                // 1: store.QueryAuditLogResponse
+	(*ExportEventRequest)(nil),                   // 2: store.ExportEventRequest
+	(*ExportEventResponse)(nil),                  // 3: store.ExportEventResponse
+	(*ImportEventRequest)(nil),                   // 4: store.ImportEventRequest
+	(*ImportEventResponse)(nil),                  // 5: store.ImportEventResponse
+	(*EraseTeamDataRequest)(nil),                 // 6: store.EraseTeamDataRequest
+	(*EraseTeamDataResponse)(nil),                // 7: store.EraseTeamDataResponse
+	(*GetEventStatsRequest)(nil),                 // 8: store.GetEventStatsRequest
+	(*GetEventStatsResponse)(nil),                // 9: store.GetEventStatsResponse
+	(*GetSolveTimelineRequest)(nil),              // 10: store.GetSolveTimelineRequest
+	(*GetSolveTimelineResponse)(nil),             // 11: store.GetSolveTimelineResponse
+	(*UpdateExerciseRequest)(nil),                // 12: store.UpdateExerciseRequest
+	(*UpdateExerciseResponse)(nil),               // 13: store.UpdateExerciseResponse
+	(*EmptyRequest)(nil),                         // 14: store.EmptyRequest
+	(*DelTeamRequest)(nil),                       // 15: store.DelTeamRequest
+	(*DelTeamResp)(nil),                          // 16: store.DelTeamResp
+	(*UpdateTeamPassRequest)(nil),                // 17: store.UpdateTeamPassRequest
+	(*GetEventIDReq)(nil),                        // 18: store.GetEventIDReq
+	(*GetEventIDResp)(nil),                       // 19: store.GetEventIDResp
+	(*GetTimeSeriesResponse)(nil),                // 20: store.GetTimeSeriesResponse
+	(*GetUsageTimeSeriesRequest)(nil),            // 21: store.GetUsageTimeSeriesRequest
+	(*GetUsageTimeSeriesResponse)(nil),           // 22: store.GetUsageTimeSeriesResponse
+	(*CheckBookingCapacityRequest)(nil),          // 23: store.CheckBookingCapacityRequest
+	(*CheckBookingCapacityResponse)(nil),         // 24: store.CheckBookingCapacityResponse
+	(*GetEventStatusRequest)(nil),                // 25: store.GetEventStatusRequest
+	(*GetEventStatusHistoryResponse)(nil),        // 26: store.GetEventStatusHistoryResponse
+	(*GetEventByTagReq)(nil),                     // 27: store.GetEventByTagReq
+	(*GetEventByTagResp)(nil),                    // 28: store.GetEventByTagResp
+	(*DropEventReq)(nil),                         // 29: store.DropEventReq
+	(*DropEventResp)(nil),                        // 30: store.DropEventResp
+	(*GetEventRequest)(nil),                      // 31: store.GetEventRequest
+	(*GetEventByUserReq)(nil),                    // 32: store.GetEventByUserReq
+	(*SetEventStatusRequest)(nil),                // 33: store.SetEventStatusRequest
+	(*EventStatusStore)(nil),                     // 34: store.EventStatusStore
+	(*AddEventRequest)(nil),                      // 35: store.AddEventRequest
+	(*AddTeamRequest)(nil),                       // 36: store.AddTeamRequest
+	(*InsertResponse)(nil),                       // 37: store.InsertResponse
+	(*GetEventResponse)(nil),                     // 38: store.GetEventResponse
+	(*GetEventTeamsRequest)(nil),                 // 39: store.GetEventTeamsRequest
+	(*GetEventTeamsResponse)(nil),                // 40: store.GetEventTeamsResponse
+	(*ListInactiveTeamsRequest)(nil),             // 41: store.ListInactiveTeamsRequest
+	(*ListInactiveTeamsResponse)(nil),            // 42: store.ListInactiveTeamsResponse
+	(*UpdateEventRequest)(nil),                   // 43: store.UpdateEventRequest
+	(*UpdateTeamSolvedChallengeRequest)(nil),     // 44: store.UpdateTeamSolvedChallengeRequest
+	(*UpdateTeamLastAccessRequest)(nil),          // 45: store.UpdateTeamLastAccessRequest
+	(*UpdateResponse)(nil),                       // 46: store.UpdateResponse
+	(*QueryAuditLogResponse_Entry)(nil),          // 47: store.QueryAuditLogResponse.Entry
+	(*EraseTeamDataResponse_Team)(nil),           // 48: store.EraseTeamDataResponse.Team
+	(*GetEventStatsResponse_Challenge)(nil),      // 49: store.GetEventStatsResponse.Challenge
+	nil,                                          // 50: store.GetSolveTimelineRequest.PointsEntry
+	(*GetSolveTimelineResponse_Bucket)(nil),      // 51: store.GetSolveTimelineResponse.Bucket
+	(*GetSolveTimelineResponse_Team)(nil),        // 52: store.GetSolveTimelineResponse.Team
+	nil,                                          // 53: store.GetSolveTimelineResponse.Bucket.SolvesEntry
+	nil,                                          // 54: store.GetTimeSeriesResponse.TimeseriesEntry
+	(*GetUsageTimeSeriesResponse_Point)(nil),     // 55: store.GetUsageTimeSeriesResponse.Point
+	(*GetEventStatusHistoryResponse_Change)(nil), // 56: store.GetEventStatusHistoryResponse.Change
+	(*GetEventResponse_Events)(nil),              // 57: store.GetEventResponse.Events
+	(*GetEventTeamsResponse_Teams)(nil),          // 58: store.GetEventTeamsResponse.Teams
+	(*ListInactiveTeamsResponse_Team)(nil),       // 59: store.ListInactiveTeamsResponse.Team
 }
 var file_store_proto_depIdxs = []int32{
-	28, // 0: store.GetTimeSeriesResponse.timeseries:type_name -> store.GetTimeSeriesResponse.TimeseriesEntry
-	29, // 1: store.GetEventResponse.events:type_name -> store.GetEventResponse.Events
-	30, // 2: store.GetEventTeamsResponse.teams:type_name -> store.GetEventTeamsResponse.Teams
-	18, // 3: store.Store.AddEvent:input_type -> store.AddEventRequest
-	19, // 4: store.Store.AddTeam:input_type -> store.AddTeamRequest
-	14, // 5: store.Store.GetEvents:input_type -> store.GetEventRequest
-	15, // 6: store.Store.GetEventByUser:input_type -> store.GetEventByUserReq
-	22, // 7: store.Store.GetEventTeams:input_type -> store.GetEventTeamsRequest
-	9,  // 8: store.Store.GetEventStatus:input_type -> store.GetEventStatusRequest
-	10, // 9: store.Store.IsEventExists:input_type -> store.GetEventByTagReq
-	2,  // 10: store.Store.GetTimeSeries:input_type -> store.EmptyRequest
-	12, // 11: store.Store.DropEvent:input_type -> store.DropEventReq
-	6,  // 12: store.Store.GetEventID:input_type -> store.GetEventIDReq
-	16, // 13: store.Store.SetEventStatus:input_type -> store.SetEventStatusRequest
-	24, // 14: store.Store.UpdateCloseEvent:input_type -> store.UpdateEventRequest
-	25, // 15: store.Store.UpdateTeamSolvedChallenge:input_type -> store.UpdateTeamSolvedChallengeRequest
-	26, // 16: store.Store.UpdateTeamLastAccess:input_type -> store.UpdateTeamLastAccessRequest
-	5,  // 17: store.Store.UpdateTeamPassword:input_type -> store.UpdateTeamPassRequest
-	0,  // 18: store.Store.UpdateExercises:input_type -> store.UpdateExerciseRequest
-	3,  // 19: store.Store.DeleteTeam:input_type -> store.DelTeamRequest
-	20, // 20: store.Store.AddEvent:output_type -> store.InsertResponse
-	20, // 21: store.Store.AddTeam:output_type -> store.InsertResponse
-	21, // 22: store.Store.GetEvents:output_type -> store.GetEventResponse
-	21, // 23: store.Store.GetEventByUser:output_type -> store.GetEventResponse
-	23, // 24: store.Store.GetEventTeams:output_type -> store.GetEventTeamsResponse
-	17, // 25: store.Store.GetEventStatus:output_type -> store.EventStatusStore
-	11, // 26: store.Store.IsEventExists:output_type -> store.GetEventByTagResp
-	8,  // 27: store.Store.GetTimeSeries:output_type -> store.GetTimeSeriesResponse
-	13, // 28: store.Store.DropEvent:output_type -> store.DropEventResp
-	7,  // 29: store.Store.GetEventID:output_type -> store.GetEventIDResp
-	17, // 30: store.Store.SetEventStatus:output_type -> store.EventStatusStore
-	27, // 31: store.Store.UpdateCloseEvent:output_type -> store.UpdateResponse
-	27, // 32: store.Store.UpdateTeamSolvedChallenge:output_type -> store.UpdateResponse
-	27, // 33: store.Store.UpdateTeamLastAccess:output_type -> store.UpdateResponse
-	27, // 34: store.Store.UpdateTeamPassword:output_type -> store.UpdateResponse
-	1,  // 35: store.Store.UpdateExercises:output_type -> store.UpdateExerciseResponse
-	4,  // 36: store.Store.DeleteTeam:output_type -> store.DelTeamResp
-	20, // [20:37] is the sub-list for method output_type
-	3,  // [3:20] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	47, // 0: store.QueryAuditLogResponse.entries:type_name -> store.QueryAuditLogResponse.Entry
+	48, // 1: store.EraseTeamDataResponse.teams:type_name -> store.EraseTeamDataResponse.Team
+	49, // 2: store.GetEventStatsResponse.challenges:type_name -> store.GetEventStatsResponse.Challenge
+	50, // 3: store.GetSolveTimelineRequest.points:type_name -> store.GetSolveTimelineRequest.PointsEntry
+	51, // 4: store.GetSolveTimelineResponse.buckets:type_name -> store.GetSolveTimelineResponse.Bucket
+	52, // 5: store.GetSolveTimelineResponse.teams:type_name -> store.GetSolveTimelineResponse.Team
+	54, // 6: store.GetTimeSeriesResponse.timeseries:type_name -> store.GetTimeSeriesResponse.TimeseriesEntry
+	55, // 7: store.GetUsageTimeSeriesResponse.points:type_name -> store.GetUsageTimeSeriesResponse.Point
+	56, // 8: store.GetEventStatusHistoryResponse.changes:type_name -> store.GetEventStatusHistoryResponse.Change
+	57, // 9: store.GetEventResponse.events:type_name -> store.GetEventResponse.Events
+	58, // 10: store.GetEventTeamsResponse.teams:type_name -> store.GetEventTeamsResponse.Teams
+	59, // 11: store.ListInactiveTeamsResponse.teams:type_name -> store.ListInactiveTeamsResponse.Team
+	53, // 12: store.GetSolveTimelineResponse.Bucket.solves:type_name -> store.GetSolveTimelineResponse.Bucket.SolvesEntry
+	35, // 13: store.Store.AddEvent:input_type -> store.AddEventRequest
+	36, // 14: store.Store.AddTeam:input_type -> store.AddTeamRequest
+	31, // 15: store.Store.GetEvents:input_type -> store.GetEventRequest
+	32, // 16: store.Store.GetEventByUser:input_type -> store.GetEventByUserReq
+	39, // 17: store.Store.GetEventTeams:input_type -> store.GetEventTeamsRequest
+	41, // 18: store.Store.ListInactiveTeams:input_type -> store.ListInactiveTeamsRequest
+	25, // 19: store.Store.GetEventStatus:input_type -> store.GetEventStatusRequest
+	25, // 20: store.Store.GetEventStatusHistory:input_type -> store.GetEventStatusRequest
+	27, // 21: store.Store.IsEventExists:input_type -> store.GetEventByTagReq
+	14, // 22: store.Store.GetTimeSeries:input_type -> store.EmptyRequest
+	21, // 23: store.Store.GetUsageTimeSeries:input_type -> store.GetUsageTimeSeriesRequest
+	23, // 24: store.Store.CheckBookingCapacity:input_type -> store.CheckBookingCapacityRequest
+	29, // 25: store.Store.DropEvent:input_type -> store.DropEventReq
+	18, // 26: store.Store.GetEventID:input_type -> store.GetEventIDReq
+	33, // 27: store.Store.SetEventStatus:input_type -> store.SetEventStatusRequest
+	43, // 28: store.Store.UpdateCloseEvent:input_type -> store.UpdateEventRequest
+	44, // 29: store.Store.UpdateTeamSolvedChallenge:input_type -> store.UpdateTeamSolvedChallengeRequest
+	45, // 30: store.Store.UpdateTeamLastAccess:input_type -> store.UpdateTeamLastAccessRequest
+	17, // 31: store.Store.UpdateTeamPassword:input_type -> store.UpdateTeamPassRequest
+	12, // 32: store.Store.UpdateExercises:input_type -> store.UpdateExerciseRequest
+	15, // 33: store.Store.DeleteTeam:input_type -> store.DelTeamRequest
+	6,  // 34: store.Store.EraseTeamData:input_type -> store.EraseTeamDataRequest
+	2,  // 35: store.Store.ExportEvent:input_type -> store.ExportEventRequest
+	4,  // 36: store.Store.ImportEvent:input_type -> store.ImportEventRequest
+	8,  // 37: store.Store.GetEventStats:input_type -> store.GetEventStatsRequest
+	10, // 38: store.Store.GetSolveTimeline:input_type -> store.GetSolveTimelineRequest
+	0,  // 39: store.Store.QueryAuditLog:input_type -> store.QueryAuditLogRequest
+	37, // 40: store.Store.AddEvent:output_type -> store.InsertResponse
+	37, // 41: store.Store.AddTeam:output_type -> store.InsertResponse
+	38, // 42: store.Store.GetEvents:output_type -> store.GetEventResponse
+	38, // 43: store.Store.GetEventByUser:output_type -> store.GetEventResponse
+	40, // 44: store.Store.GetEventTeams:output_type -> store.GetEventTeamsResponse
+	42, // 45: store.Store.ListInactiveTeams:output_type -> store.ListInactiveTeamsResponse
+	34, // 46: store.Store.GetEventStatus:output_type -> store.EventStatusStore
+	26, // 47: store.Store.GetEventStatusHistory:output_type -> store.GetEventStatusHistoryResponse
+	28, // 48: store.Store.IsEventExists:output_type -> store.GetEventByTagResp
+	20, // 49: store.Store.GetTimeSeries:output_type -> store.GetTimeSeriesResponse
+	22, // 50: store.Store.GetUsageTimeSeries:output_type -> store.GetUsageTimeSeriesResponse
+	24, // 51: store.Store.CheckBookingCapacity:output_type -> store.CheckBookingCapacityResponse
+	30, // 52: store.Store.DropEvent:output_type -> store.DropEventResp
+	19, // 53: store.Store.GetEventID:output_type -> store.GetEventIDResp
+	34, // 54: store.Store.SetEventStatus:output_type -> store.EventStatusStore
+	46, // 55: store.Store.UpdateCloseEvent:output_type -> store.UpdateResponse
+	46, // 56: store.Store.UpdateTeamSolvedChallenge:output_type -> store.UpdateResponse
+	46, // 57: store.Store.UpdateTeamLastAccess:output_type -> store.UpdateResponse
+	46, // 58: store.Store.UpdateTeamPassword:output_type -> store.UpdateResponse
+	13, // 59: store.Store.UpdateExercises:output_type -> store.UpdateExerciseResponse
+	16, // 60: store.Store.DeleteTeam:output_type -> store.DelTeamResp
+	7,  // 61: store.Store.EraseTeamData:output_type -> store.EraseTeamDataResponse
+	3,  // 62: store.Store.ExportEvent:output_type -> store.ExportEventResponse
+	5,  // 63: store.Store.ImportEvent:output_type -> store.ImportEventResponse
+	9,  // 64: store.Store.GetEventStats:output_type -> store.GetEventStatsResponse
+	11, // 65: store.Store.GetSolveTimeline:output_type -> store.GetSolveTimelineResponse
+	1,  // 66: store.Store.QueryAuditLog:output_type -> store.QueryAuditLogResponse
+	40, // [40:67] is the sub-list for method output_type
+	13, // [13:40] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_store_proto_init() }
@@ -2228,6 +4456,150 @@ func file_store_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_store_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAuditLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAuditLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraseTeamDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraseTeamDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSolveTimelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSolveTimelineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateExerciseRequest); i {
 			case 0:
 				return &v.state
@@ -2239,7 +4611,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateExerciseResponse); i {
 			case 0:
 				return &v.state
@@ -2251,7 +4623,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmptyRequest); i {
 			case 0:
 				return &v.state
@@ -2263,7 +4635,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DelTeamRequest); i {
 			case 0:
 				return &v.state
@@ -2275,7 +4647,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DelTeamResp); i {
 			case 0:
 				return &v.state
@@ -2287,7 +4659,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateTeamPassRequest); i {
 			case 0:
 				return &v.state
@@ -2299,7 +4671,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEventIDReq); i {
 			case 0:
 				return &v.state
@@ -2311,7 +4683,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEventIDResp); i {
 			case 0:
 				return &v.state
@@ -2323,7 +4695,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTimeSeriesResponse); i {
 			case 0:
 				return &v.state
@@ -2335,7 +4707,55 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsageTimeSeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsageTimeSeriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckBookingCapacityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckBookingCapacityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEventStatusRequest); i {
 			case 0:
 				return &v.state
@@ -2347,7 +4767,19 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventStatusHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEventByTagReq); i {
 			case 0:
 				return &v.state
@@ -2359,7 +4791,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEventByTagResp); i {
 			case 0:
 				return &v.state
@@ -2371,7 +4803,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DropEventReq); i {
 			case 0:
 				return &v.state
@@ -2383,7 +4815,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DropEventResp); i {
 			case 0:
 				return &v.state
@@ -2395,7 +4827,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEventRequest); i {
 			case 0:
 				return &v.state
@@ -2407,7 +4839,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEventByUserReq); i {
 			case 0:
 				return &v.state
@@ -2419,7 +4851,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetEventStatusRequest); i {
 			case 0:
 				return &v.state
@@ -2431,7 +4863,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventStatusStore); i {
 			case 0:
 				return &v.state
@@ -2443,7 +4875,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddEventRequest); i {
 			case 0:
 				return &v.state
@@ -2455,7 +4887,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddTeamRequest); i {
 			case 0:
 				return &v.state
@@ -2467,7 +4899,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InsertResponse); i {
 			case 0:
 				return &v.state
@@ -2479,7 +4911,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEventResponse); i {
 			case 0:
 				return &v.state
@@ -2491,7 +4923,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEventTeamsRequest); i {
 			case 0:
 				return &v.state
@@ -2503,7 +4935,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEventTeamsResponse); i {
 			case 0:
 				return &v.state
@@ -2515,7 +4947,31 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInactiveTeamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInactiveTeamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateEventRequest); i {
 			case 0:
 				return &v.state
@@ -2527,7 +4983,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateTeamSolvedChallengeRequest); i {
 			case 0:
 				return &v.state
@@ -2539,7 +4995,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateTeamLastAccessRequest); i {
 			case 0:
 				return &v.state
@@ -2551,7 +5007,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateResponse); i {
 			case 0:
 				return &v.state
@@ -2563,7 +5019,91 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAuditLogResponse_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraseTeamDataResponse_Team); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventStatsResponse_Challenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSolveTimelineResponse_Bucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSolveTimelineResponse_Team); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsageTimeSeriesResponse_Point); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventStatusHistoryResponse_Change); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEventResponse_Events); i {
 			case 0:
 				return &v.state
@@ -2575,7 +5115,7 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
-		file_store_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_store_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetEventTeamsResponse_Teams); i {
 			case 0:
 				return &v.state
@@ -2587,6 +5127,18 @@ func file_store_proto_init() {
 				return nil
 			}
 		}
+		file_store_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInactiveTeamsResponse_Team); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2594,7 +5146,7 @@ func file_store_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
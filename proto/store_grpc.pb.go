@@ -25,9 +25,13 @@ type StoreClient interface {
 	GetEvents(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error)
 	GetEventByUser(ctx context.Context, in *GetEventByUserReq, opts ...grpc.CallOption) (*GetEventResponse, error)
 	GetEventTeams(ctx context.Context, in *GetEventTeamsRequest, opts ...grpc.CallOption) (*GetEventTeamsResponse, error)
+	ListInactiveTeams(ctx context.Context, in *ListInactiveTeamsRequest, opts ...grpc.CallOption) (*ListInactiveTeamsResponse, error)
 	GetEventStatus(ctx context.Context, in *GetEventStatusRequest, opts ...grpc.CallOption) (*EventStatusStore, error)
+	GetEventStatusHistory(ctx context.Context, in *GetEventStatusRequest, opts ...grpc.CallOption) (*GetEventStatusHistoryResponse, error)
 	IsEventExists(ctx context.Context, in *GetEventByTagReq, opts ...grpc.CallOption) (*GetEventByTagResp, error)
 	GetTimeSeries(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetTimeSeriesResponse, error)
+	GetUsageTimeSeries(ctx context.Context, in *GetUsageTimeSeriesRequest, opts ...grpc.CallOption) (*GetUsageTimeSeriesResponse, error)
+	CheckBookingCapacity(ctx context.Context, in *CheckBookingCapacityRequest, opts ...grpc.CallOption) (*CheckBookingCapacityResponse, error)
 	DropEvent(ctx context.Context, in *DropEventReq, opts ...grpc.CallOption) (*DropEventResp, error)
 	GetEventID(ctx context.Context, in *GetEventIDReq, opts ...grpc.CallOption) (*GetEventIDResp, error)
 	SetEventStatus(ctx context.Context, in *SetEventStatusRequest, opts ...grpc.CallOption) (*EventStatusStore, error)
@@ -39,6 +43,16 @@ type StoreClient interface {
 	UpdateExercises(ctx context.Context, in *UpdateExerciseRequest, opts ...grpc.CallOption) (*UpdateExerciseResponse, error)
 	// Delete
 	DeleteTeam(ctx context.Context, in *DelTeamRequest, opts ...grpc.CallOption) (*DelTeamResp, error)
+	// EraseTeamData anonymizes teams with given email in every event, requires admin scope
+	EraseTeamData(ctx context.Context, in *EraseTeamDataRequest, opts ...grpc.CallOption) (*EraseTeamDataResponse, error)
+	// Export
+	ExportEvent(ctx context.Context, in *ExportEventRequest, opts ...grpc.CallOption) (*ExportEventResponse, error)
+	ImportEvent(ctx context.Context, in *ImportEventRequest, opts ...grpc.CallOption) (*ImportEventResponse, error)
+	// Statistics
+	GetEventStats(ctx context.Context, in *GetEventStatsRequest, opts ...grpc.CallOption) (*GetEventStatsResponse, error)
+	GetSolveTimeline(ctx context.Context, in *GetSolveTimelineRequest, opts ...grpc.CallOption) (*GetSolveTimelineResponse, error)
+	// Audit
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
 }
 
 type storeClient struct {
@@ -94,6 +108,15 @@ func (c *storeClient) GetEventTeams(ctx context.Context, in *GetEventTeamsReques
 	return out, nil
 }
 
+func (c *storeClient) ListInactiveTeams(ctx context.Context, in *ListInactiveTeamsRequest, opts ...grpc.CallOption) (*ListInactiveTeamsResponse, error) {
+	out := new(ListInactiveTeamsResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/ListInactiveTeams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storeClient) GetEventStatus(ctx context.Context, in *GetEventStatusRequest, opts ...grpc.CallOption) (*EventStatusStore, error) {
 	out := new(EventStatusStore)
 	err := c.cc.Invoke(ctx, "/store.Store/GetEventStatus", in, out, opts...)
@@ -103,6 +126,15 @@ func (c *storeClient) GetEventStatus(ctx context.Context, in *GetEventStatusRequ
 	return out, nil
 }
 
+func (c *storeClient) GetEventStatusHistory(ctx context.Context, in *GetEventStatusRequest, opts ...grpc.CallOption) (*GetEventStatusHistoryResponse, error) {
+	out := new(GetEventStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/GetEventStatusHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storeClient) IsEventExists(ctx context.Context, in *GetEventByTagReq, opts ...grpc.CallOption) (*GetEventByTagResp, error) {
 	out := new(GetEventByTagResp)
 	err := c.cc.Invoke(ctx, "/store.Store/IsEventExists", in, out, opts...)
@@ -121,6 +153,24 @@ func (c *storeClient) GetTimeSeries(ctx context.Context, in *EmptyRequest, opts
 	return out, nil
 }
 
+func (c *storeClient) GetUsageTimeSeries(ctx context.Context, in *GetUsageTimeSeriesRequest, opts ...grpc.CallOption) (*GetUsageTimeSeriesResponse, error) {
+	out := new(GetUsageTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/GetUsageTimeSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeClient) CheckBookingCapacity(ctx context.Context, in *CheckBookingCapacityRequest, opts ...grpc.CallOption) (*CheckBookingCapacityResponse, error) {
+	out := new(CheckBookingCapacityResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/CheckBookingCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storeClient) DropEvent(ctx context.Context, in *DropEventReq, opts ...grpc.CallOption) (*DropEventResp, error) {
 	out := new(DropEventResp)
 	err := c.cc.Invoke(ctx, "/store.Store/DropEvent", in, out, opts...)
@@ -202,6 +252,60 @@ func (c *storeClient) DeleteTeam(ctx context.Context, in *DelTeamRequest, opts .
 	return out, nil
 }
 
+func (c *storeClient) EraseTeamData(ctx context.Context, in *EraseTeamDataRequest, opts ...grpc.CallOption) (*EraseTeamDataResponse, error) {
+	out := new(EraseTeamDataResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/EraseTeamData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeClient) ExportEvent(ctx context.Context, in *ExportEventRequest, opts ...grpc.CallOption) (*ExportEventResponse, error) {
+	out := new(ExportEventResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/ExportEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeClient) ImportEvent(ctx context.Context, in *ImportEventRequest, opts ...grpc.CallOption) (*ImportEventResponse, error) {
+	out := new(ImportEventResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/ImportEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeClient) GetEventStats(ctx context.Context, in *GetEventStatsRequest, opts ...grpc.CallOption) (*GetEventStatsResponse, error) {
+	out := new(GetEventStatsResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/GetEventStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeClient) GetSolveTimeline(ctx context.Context, in *GetSolveTimelineRequest, opts ...grpc.CallOption) (*GetSolveTimelineResponse, error) {
+	out := new(GetSolveTimelineResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/GetSolveTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/store.Store/QueryAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StoreServer is the server API for Store service.
 // All implementations must embed UnimplementedStoreServer
 // for forward compatibility
@@ -213,9 +317,13 @@ type StoreServer interface {
 	GetEvents(context.Context, *GetEventRequest) (*GetEventResponse, error)
 	GetEventByUser(context.Context, *GetEventByUserReq) (*GetEventResponse, error)
 	GetEventTeams(context.Context, *GetEventTeamsRequest) (*GetEventTeamsResponse, error)
+	ListInactiveTeams(context.Context, *ListInactiveTeamsRequest) (*ListInactiveTeamsResponse, error)
 	GetEventStatus(context.Context, *GetEventStatusRequest) (*EventStatusStore, error)
+	GetEventStatusHistory(context.Context, *GetEventStatusRequest) (*GetEventStatusHistoryResponse, error)
 	IsEventExists(context.Context, *GetEventByTagReq) (*GetEventByTagResp, error)
 	GetTimeSeries(context.Context, *EmptyRequest) (*GetTimeSeriesResponse, error)
+	GetUsageTimeSeries(context.Context, *GetUsageTimeSeriesRequest) (*GetUsageTimeSeriesResponse, error)
+	CheckBookingCapacity(context.Context, *CheckBookingCapacityRequest) (*CheckBookingCapacityResponse, error)
 	DropEvent(context.Context, *DropEventReq) (*DropEventResp, error)
 	GetEventID(context.Context, *GetEventIDReq) (*GetEventIDResp, error)
 	SetEventStatus(context.Context, *SetEventStatusRequest) (*EventStatusStore, error)
@@ -227,6 +335,16 @@ type StoreServer interface {
 	UpdateExercises(context.Context, *UpdateExerciseRequest) (*UpdateExerciseResponse, error)
 	// Delete
 	DeleteTeam(context.Context, *DelTeamRequest) (*DelTeamResp, error)
+	// EraseTeamData anonymizes teams with given email in every event, requires admin scope
+	EraseTeamData(context.Context, *EraseTeamDataRequest) (*EraseTeamDataResponse, error)
+	// Export
+	ExportEvent(context.Context, *ExportEventRequest) (*ExportEventResponse, error)
+	ImportEvent(context.Context, *ImportEventRequest) (*ImportEventResponse, error)
+	// Statistics
+	GetEventStats(context.Context, *GetEventStatsRequest) (*GetEventStatsResponse, error)
+	GetSolveTimeline(context.Context, *GetSolveTimelineRequest) (*GetSolveTimelineResponse, error)
+	// Audit
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
 	mustEmbedUnimplementedStoreServer()
 }
 
@@ -249,15 +367,27 @@ func (UnimplementedStoreServer) GetEventByUser(context.Context, *GetEventByUserR
 func (UnimplementedStoreServer) GetEventTeams(context.Context, *GetEventTeamsRequest) (*GetEventTeamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEventTeams not implemented")
 }
+func (UnimplementedStoreServer) ListInactiveTeams(context.Context, *ListInactiveTeamsRequest) (*ListInactiveTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInactiveTeams not implemented")
+}
 func (UnimplementedStoreServer) GetEventStatus(context.Context, *GetEventStatusRequest) (*EventStatusStore, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEventStatus not implemented")
 }
+func (UnimplementedStoreServer) GetEventStatusHistory(context.Context, *GetEventStatusRequest) (*GetEventStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventStatusHistory not implemented")
+}
 func (UnimplementedStoreServer) IsEventExists(context.Context, *GetEventByTagReq) (*GetEventByTagResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsEventExists not implemented")
 }
 func (UnimplementedStoreServer) GetTimeSeries(context.Context, *EmptyRequest) (*GetTimeSeriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTimeSeries not implemented")
 }
+func (UnimplementedStoreServer) GetUsageTimeSeries(context.Context, *GetUsageTimeSeriesRequest) (*GetUsageTimeSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageTimeSeries not implemented")
+}
+func (UnimplementedStoreServer) CheckBookingCapacity(context.Context, *CheckBookingCapacityRequest) (*CheckBookingCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckBookingCapacity not implemented")
+}
 func (UnimplementedStoreServer) DropEvent(context.Context, *DropEventReq) (*DropEventResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropEvent not implemented")
 }
@@ -285,6 +415,24 @@ func (UnimplementedStoreServer) UpdateExercises(context.Context, *UpdateExercise
 func (UnimplementedStoreServer) DeleteTeam(context.Context, *DelTeamRequest) (*DelTeamResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
 }
+func (UnimplementedStoreServer) EraseTeamData(context.Context, *EraseTeamDataRequest) (*EraseTeamDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseTeamData not implemented")
+}
+func (UnimplementedStoreServer) ExportEvent(context.Context, *ExportEventRequest) (*ExportEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportEvent not implemented")
+}
+func (UnimplementedStoreServer) ImportEvent(context.Context, *ImportEventRequest) (*ImportEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportEvent not implemented")
+}
+func (UnimplementedStoreServer) GetEventStats(context.Context, *GetEventStatsRequest) (*GetEventStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventStats not implemented")
+}
+func (UnimplementedStoreServer) GetSolveTimeline(context.Context, *GetSolveTimelineRequest) (*GetSolveTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSolveTimeline not implemented")
+}
+func (UnimplementedStoreServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
 func (UnimplementedStoreServer) mustEmbedUnimplementedStoreServer() {}
 
 // UnsafeStoreServer may be embedded to opt out of forward compatibility for this service.
@@ -388,6 +536,24 @@ func _Store_GetEventTeams_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Store_ListInactiveTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInactiveTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).ListInactiveTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/ListInactiveTeams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).ListInactiveTeams(ctx, req.(*ListInactiveTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Store_GetEventStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEventStatusRequest)
 	if err := dec(in); err != nil {
@@ -406,6 +572,24 @@ func _Store_GetEventStatus_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Store_GetEventStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).GetEventStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/GetEventStatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).GetEventStatusHistory(ctx, req.(*GetEventStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Store_IsEventExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEventByTagReq)
 	if err := dec(in); err != nil {
@@ -442,6 +626,42 @@ func _Store_GetTimeSeries_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Store_GetUsageTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).GetUsageTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/GetUsageTimeSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).GetUsageTimeSeries(ctx, req.(*GetUsageTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Store_CheckBookingCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckBookingCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).CheckBookingCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/CheckBookingCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).CheckBookingCapacity(ctx, req.(*CheckBookingCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Store_DropEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DropEventReq)
 	if err := dec(in); err != nil {
@@ -604,6 +824,114 @@ func _Store_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Store_EraseTeamData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseTeamDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).EraseTeamData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/EraseTeamData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).EraseTeamData(ctx, req.(*EraseTeamDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Store_ExportEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).ExportEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/ExportEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).ExportEvent(ctx, req.(*ExportEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Store_ImportEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).ImportEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/ImportEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).ImportEvent(ctx, req.(*ImportEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Store_GetEventStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).GetEventStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/GetEventStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).GetEventStats(ctx, req.(*GetEventStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Store_GetSolveTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSolveTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).GetSolveTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/GetSolveTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).GetSolveTimeline(ctx, req.(*GetSolveTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Store_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/store.Store/QueryAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Store_ServiceDesc is the grpc.ServiceDesc for Store service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -631,10 +959,18 @@ var Store_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEventTeams",
 			Handler:    _Store_GetEventTeams_Handler,
 		},
+		{
+			MethodName: "ListInactiveTeams",
+			Handler:    _Store_ListInactiveTeams_Handler,
+		},
 		{
 			MethodName: "GetEventStatus",
 			Handler:    _Store_GetEventStatus_Handler,
 		},
+		{
+			MethodName: "GetEventStatusHistory",
+			Handler:    _Store_GetEventStatusHistory_Handler,
+		},
 		{
 			MethodName: "IsEventExists",
 			Handler:    _Store_IsEventExists_Handler,
@@ -643,6 +979,14 @@ var Store_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTimeSeries",
 			Handler:    _Store_GetTimeSeries_Handler,
 		},
+		{
+			MethodName: "GetUsageTimeSeries",
+			Handler:    _Store_GetUsageTimeSeries_Handler,
+		},
+		{
+			MethodName: "CheckBookingCapacity",
+			Handler:    _Store_CheckBookingCapacity_Handler,
+		},
 		{
 			MethodName: "DropEvent",
 			Handler:    _Store_DropEvent_Handler,
@@ -679,6 +1023,30 @@ var Store_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTeam",
 			Handler:    _Store_DeleteTeam_Handler,
 		},
+		{
+			MethodName: "EraseTeamData",
+			Handler:    _Store_EraseTeamData_Handler,
+		},
+		{
+			MethodName: "ExportEvent",
+			Handler:    _Store_ExportEvent_Handler,
+		},
+		{
+			MethodName: "ImportEvent",
+			Handler:    _Store_ImportEvent_Handler,
+		},
+		{
+			MethodName: "GetEventStats",
+			Handler:    _Store_GetEventStats_Handler,
+		},
+		{
+			MethodName: "GetSolveTimeline",
+			Handler:    _Store_GetSolveTimeline_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _Store_QueryAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "store.proto",
@@ -0,0 +1,187 @@
+package util
+
+import (
+	"context"
+	"log"
+	"path"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveFields are never written into audit log
+var sensitiveFields = map[protoreflect.Name]bool{
+	"password":      true,
+	"encryptedPass": true,
+	"secretKey":     true,
+	"hashPassword":  true,
+	"archive":       true,
+}
+
+// selfAudited are RPCs which write their own audit entries,
+// e.g. EraseTeamData records each erased team without the email
+var selfAudited = map[string]bool{
+	"/store.Store/EraseTeamData": true,
+}
+
+// auditor records every RPC which requires more than the read scope into audit log of the store
+type auditor struct {
+	store   database.Store
+	exclude map[string]bool
+}
+
+func newAuditor(store database.Store, conf *model.Config) *auditor {
+	a := &auditor{store: store, exclude: map[string]bool{}}
+	for _, m := range conf.Audit.Exclude {
+		a.exclude[m] = true
+	}
+	return a
+}
+
+func (a *auditor) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if methodScope(info.FullMethod) == ReadScope || selfAudited[info.FullMethod] || a.exclude[path.Base(info.FullMethod)] {
+		return handler(ctx, req)
+	}
+
+	resp, err := handler(ctx, req)
+	a.record(ctx, info.FullMethod, req, resp, err)
+	return resp, err
+}
+
+func (a *auditor) record(ctx context.Context, method string, req, resp interface{}, err error) {
+	entry := model.AuditEntry{
+		CreatedAt: time.Now().UTC(),
+		Method:    path.Base(method),
+	}
+	if id, ok := IdentityFromContext(ctx); ok {
+		entry.Caller = id.String()
+	}
+	entry.EventTag, entry.TeamId = auditTarget(req)
+	if m, ok := req.(proto.Message); ok {
+		entry.Request = sanitizeRequest(m)
+	}
+
+	code, msg := callResult(resp, err)
+	entry.Code = code.String()
+	entry.ErrorMessage = msg
+
+	if err := a.store.AddAuditEntry(entry); err != nil {
+		log.Printf("ERR: Error writing audit log for %s: %s", entry.Method, err.Error())
+	}
+}
+
+// auditTarget returns event tag and team id which are modified by the request
+func auditTarget(req interface{}) (string, string) {
+	switch r := req.(type) {
+	case *pb.AddEventRequest:
+		return r.Tag, ""
+	case *pb.AddTeamRequest:
+		return r.EventTag, r.Id
+	case *pb.DropEventReq:
+		return r.Tag, ""
+	case *pb.SetEventStatusRequest:
+		return r.EventTag, ""
+	case *pb.UpdateEventRequest:
+		return r.OldTag, ""
+	case *pb.UpdateExerciseRequest:
+		return r.EventTag, ""
+	case *pb.UpdateTeamSolvedChallengeRequest:
+		return "", r.TeamId
+	case *pb.UpdateTeamLastAccessRequest:
+		return "", r.TeamId
+	case *pb.UpdateTeamPassRequest:
+		return "", r.TeamID
+	case *pb.DelTeamRequest:
+		return r.EvTag, r.TeamId
+	case *pb.ImportEventRequest:
+		return r.NewTag, ""
+	}
+	return "", ""
+}
+
+// sanitizeRequest returns request in JSON where sensitive fields are redacted
+func sanitizeRequest(m proto.Message) string {
+	c := proto.Clone(m).ProtoReflect()
+	fields := c.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.Get(i)
+		if !sensitiveFields[f.Name()] {
+			continue
+		}
+		switch {
+		case f.Kind() == protoreflect.StringKind && c.Get(f).String() != "":
+			c.Set(f, protoreflect.ValueOfString(redacted))
+		case f.Kind() == protoreflect.BytesKind && len(c.Get(f).Bytes()) > 0:
+			c.Set(f, protoreflect.ValueOfBytes([]byte(redacted)))
+		}
+	}
+	b, err := protojson.Marshal(c.Interface())
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// callResult returns code and error message of the call, most of the calls
+// report failures in response message instead of error, their code is Unknown
+func callResult(resp interface{}, err error) (codes.Code, string) {
+	if err != nil {
+		st, _ := status.FromError(err)
+		return st.Code(), st.Message()
+	}
+	if msg := responseError(resp); msg != "" {
+		return codes.Unknown, msg
+	}
+	return codes.OK, ""
+}
+
+// responseError returns errorMessage field of the response if there is any.
+// Responses without it, such as UpdateExerciseResponse, report failures by error.
+func responseError(resp interface{}) string {
+	m, ok := resp.(proto.Message)
+	if !ok || m == nil {
+		return ""
+	}
+	r := m.ProtoReflect()
+	if !r.IsValid() {
+		return ""
+	}
+	f := r.Descriptor().Fields().ByName("errorMessage")
+	if f == nil || f.Kind() != protoreflect.StringKind {
+		return ""
+	}
+	return r.Get(f).String()
+}
+
+func (s server) QueryAuditLog(ctx context.Context, in *pb.QueryAuditLogRequest) (*pb.QueryAuditLogResponse, error) {
+	result, err := s.store.QueryAuditLog(in)
+	if err != nil {
+		log.Printf("ERR: Error Query Audit Log %s", err.Error())
+		return &pb.QueryAuditLogResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	var entries []*pb.QueryAuditLogResponse_Entry
+	for _, e := range result {
+		entries = append(entries, &pb.QueryAuditLogResponse_Entry{
+			CreatedAt:    e.CreatedAt.Format(database.TimeFormat),
+			Method:       e.Method,
+			Caller:       e.Caller,
+			EventTag:     e.EventTag,
+			TeamId:       e.TeamId,
+			Request:      e.Request,
+			Code:         e.Code,
+			ErrorMessage: e.ErrorMessage,
+		})
+	}
+	return &pb.QueryAuditLogResponse{Entries: entries}, nil
+}
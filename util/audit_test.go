@@ -0,0 +1,124 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// auditStore keeps audit entries in memory, mutations of teams and exercises fail
+type auditStore struct {
+	database.Store
+	entries []model.AuditEntry
+}
+
+func (s *auditStore) DelTeam(req *pb.DelTeamRequest) (string, error) {
+	return "", errors.New("no such team")
+}
+
+func (s *auditStore) UpdateExercises(req *pb.UpdateExerciseRequest) (string, error) {
+	return "", errors.New("no such event")
+}
+
+func (s *auditStore) AddAuditEntry(e model.AuditEntry) error {
+	s.entries = append(s.entries, e)
+	return nil
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	tt := []struct {
+		name     string
+		method   string
+		req      interface{}
+		resp     interface{}
+		err      error
+		recorded bool
+		event    string
+		team     string
+		code     string
+	}{
+		{name: "Read call", method: "/store.Store/GetEvents", req: &pb.GetEventRequest{}, resp: &pb.GetEventResponse{}},
+		{name: "Excluded call", method: "/store.Store/UpdateTeamLastAccess", req: &pb.UpdateTeamLastAccessRequest{TeamId: "team"}, resp: &pb.UpdateResponse{}},
+		{name: "Add team", method: "/store.Store/AddTeam", req: &pb.AddTeamRequest{Id: "team", EventTag: "test", Password: "secret"},
+			resp: &pb.InsertResponse{}, recorded: true, event: "test", team: "team", code: "OK"},
+		{name: "Error in response", method: "/store.Store/UpdateTeamSolvedChallenge", req: &pb.UpdateTeamSolvedChallengeRequest{TeamId: "team"},
+			resp: &pb.UpdateResponse{ErrorMessage: "no such team"}, recorded: true, team: "team", code: "Unknown"},
+		{name: "Admin call", method: "/store.Store/QueryAuditLog", req: &pb.QueryAuditLogRequest{}, resp: &pb.QueryAuditLogResponse{}, recorded: true, code: "OK"},
+		{name: "Self audited call", method: "/store.Store/EraseTeamData", req: &pb.EraseTeamDataRequest{Email: "alice@example.com"}, resp: &pb.EraseTeamDataResponse{}},
+		{name: "Unmapped call", method: "/store.Store/Unknown", req: &pb.EmptyRequest{}, resp: &pb.InsertResponse{}, recorded: true, code: "OK"},
+		{name: "Error", method: "/store.Store/DropEvent", req: &pb.DropEventReq{Tag: "test"},
+			err: status.Error(codes.NotFound, "no event"), recorded: true, event: "test", code: "NotFound"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &auditStore{}
+			var conf model.Config
+			conf.Audit.Exclude = []string{"UpdateTeamLastAccess"}
+			a := newAuditor(store, &conf)
+
+			ctx := NewIdentityContext(context.Background(), &Identity{Name: "daemon", Source: CertAuthMode})
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return tc.resp, tc.err
+			}
+			if _, err := a.unaryInterceptor(ctx, tc.req, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler); !errors.Is(err, tc.err) {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if !tc.recorded {
+				if len(store.entries) != 0 {
+					t.Fatalf("expected no audit entry, but received %d", len(store.entries))
+				}
+				return
+			}
+			if len(store.entries) != 1 {
+				t.Fatalf("expected one audit entry, but received %d", len(store.entries))
+			}
+			e := store.entries[0]
+			if e.Caller != "cert:daemon" || e.EventTag != tc.event || e.TeamId != tc.team || e.Code != tc.code {
+				t.Fatalf("unexpected audit entry %+v", e)
+			}
+			if strings.Contains(e.Request, "secret") {
+				t.Fatalf("audit entry contains secret: %s", e.Request)
+			}
+		})
+	}
+}
+
+// failures of calls which report them in message field are recorded as well
+func TestAuditFailedMutations(t *testing.T) {
+	store := &auditStore{}
+	a := newAuditor(store, &model.Config{})
+	s := server{store: store}
+	ctx := NewIdentityContext(context.Background(), &Identity{Name: "daemon", Source: CertAuthMode})
+
+	deleteTeam := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.DeleteTeam(ctx, req.(*pb.DelTeamRequest))
+	}
+	resp, _ := a.unaryInterceptor(ctx, &pb.DelTeamRequest{EvTag: "test", TeamId: "team"}, &grpc.UnaryServerInfo{FullMethod: "/store.Store/DeleteTeam"}, deleteTeam)
+	if resp.(*pb.DelTeamResp).ErrorMessage == "" {
+		t.Errorf("expected error in DeleteTeam response")
+	}
+
+	updateExercises := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.UpdateExercises(ctx, req.(*pb.UpdateExerciseRequest))
+	}
+	a.unaryInterceptor(ctx, &pb.UpdateExerciseRequest{EventTag: "test"}, &grpc.UnaryServerInfo{FullMethod: "/store.Store/UpdateExercises"}, updateExercises)
+
+	if len(store.entries) != 2 {
+		t.Fatalf("expected two audit entries, but received %d", len(store.entries))
+	}
+	for _, e := range store.entries {
+		if e.Code == codes.OK.String() || e.ErrorMessage == "" {
+			t.Errorf("expected failure to be recorded, got %+v", e)
+		}
+	}
+}
@@ -4,72 +4,148 @@ import (
 	"context"
 	"errors"
 	"fmt"
+
+	"github.com/aau-network-security/haaukins-store/model"
 	jwt "github.com/golang-jwt/jwt/v4"
 	"google.golang.org/grpc/metadata"
 )
 
 const (
 	AUTH_KEY = "au"
+	// AnonymousName is the identity name of tokens without sub claim, e.g. legacy HMAC tokens
+	AnonymousName = "anonymous"
 )
 
 var (
 	InvalidAuthKey        = errors.New("Invalid Authentication Key")
 	InvalidTokenFormatErr = errors.New("Invalid token format")
 	MissingKeyErr         = errors.New("No Authentication Key provided")
+	InvalidIssuerErr      = errors.New("Invalid token issuer")
+	InvalidAudienceErr    = errors.New("Invalid token audience")
 )
 
+// asymmetricMethods are the signing methods verified against the JWKS key set
+var asymmetricMethods = []string{
+	jwt.SigningMethodRS256.Alg(),
+	jwt.SigningMethodES256.Alg(),
+	jwt.SigningMethodEdDSA.Alg(),
+}
+
 type Authenticator interface {
-	AuthenticateContext(context.Context) error
+	AuthenticateContext(context.Context) (*Identity, error)
 }
 
 type auth struct {
 	sKey string // Signin Key
 	aKey string // Auth Key
+
+	keys        *keySet // public keys for asymmetric tokens, nil if not configured
+	issuer      string
+	audience    string
+	disableHMAC bool
 }
 
 func NewAuthenticator(Skey, AKey string) Authenticator {
 	return &auth{sKey: Skey, aKey: AKey}
 }
 
-func (a *auth) AuthenticateContext(ctx context.Context) error {
+// newAuthenticator returns authenticator from configuration,
+// when JWKS file is given, asymmetric tokens are verified against its keys
+// and depending on client certificate mode, identity is taken from certificates
+func newAuthenticator(conf *model.Config) (Authenticator, error) {
+	a := &auth{sKey: conf.SigninKey, aKey: conf.AuthKey}
+	if conf.JWKS.File != "" {
+		keys, err := newKeySet(conf.JWKS.File)
+		if err != nil {
+			return nil, fmt.Errorf("could not load key set: %v", err)
+		}
+		a.keys = keys
+		a.issuer = conf.JWKS.Issuer
+		a.audience = conf.JWKS.Audience
+		a.disableHMAC = conf.JWKS.DisableHMAC
+	}
+
+	mode := conf.ClientCerts.Mode
+	if mode == "" || mode == JWTAuthMode {
+		return a, nil
+	}
+	return &combinedAuth{mode: mode, jwt: a, cert: newCertAuthenticator(conf)}, nil
+}
+
+func (a *auth) AuthenticateContext(ctx context.Context) (*Identity, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return MissingKeyErr
+		return nil, MissingKeyErr
 	}
 
 	if len(md["token"]) == 0 {
-		return MissingKeyErr
+		return nil, MissingKeyErr
 	}
 
 	token := md["token"][0]
 	if token == "" {
-		return MissingKeyErr
+		return nil, MissingKeyErr
 	}
 
 	jwtToken, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return ctx, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+		switch token.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			if a.disableHMAC {
+				return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+			}
+			return []byte(a.sKey), nil
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA, *jwt.SigningMethodEd25519:
+			if a.keys == nil || !isAsymmetricMethod(token.Method.Alg()) {
+				return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+			}
+			return a.keys.lookup(token)
 		}
-
-		return []byte(a.sKey), nil
+		return ctx, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	claims, ok := jwtToken.Claims.(jwt.MapClaims)
 	if !ok || !jwtToken.Valid {
-		return InvalidTokenFormatErr
+		return nil, InvalidTokenFormatErr
+	}
+
+	name, _ := claims["sub"].(string)
+	if name == "" {
+		name = AnonymousName
+	}
+	id := &Identity{Name: name, Source: JWTAuthMode, Scopes: scopesFromClaim(claims["scope"])}
+
+	if _, ok := jwtToken.Method.(*jwt.SigningMethodHMAC); !ok {
+		// tokens signed by the issuing service carry no shared auth key,
+		// they are trusted by their signature, issuer and audience
+		if a.issuer != "" && !claims.VerifyIssuer(a.issuer, true) {
+			return nil, InvalidIssuerErr
+		}
+		if a.audience != "" && !claims.VerifyAudience(a.audience, true) {
+			return nil, InvalidAudienceErr
+		}
+		return id, nil
 	}
 
 	authKey, ok := claims[AUTH_KEY].(string)
 	if !ok {
-		return InvalidTokenFormatErr
+		return nil, InvalidTokenFormatErr
 	}
 
 	if authKey != a.aKey {
-		return InvalidAuthKey
+		return nil, InvalidAuthKey
 	}
 
-	return nil
+	return id, nil
+}
+
+func isAsymmetricMethod(alg string) bool {
+	for _, m := range asymmetricMethods {
+		if m == alg {
+			return true
+		}
+	}
+	return false
 }
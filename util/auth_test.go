@@ -0,0 +1,140 @@
+package util
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/metadata"
+)
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func writeKeySet(t *testing.T, path string, keys ...jwk) {
+	data, err := json.Marshal(map[string][]jwk{"keys": keys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func tokenContext(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims jwt.MapClaims) context.Context {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("token", signed))
+}
+
+func TestAuthenticateKeySet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jwks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	edPub, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	otherRSAKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	path := filepath.Join(dir, "jwks.json")
+	writeKeySet(t, path,
+		jwk{Kty: "RSA", Kid: "rsa", N: b64(rsaKey.N.Bytes()), E: b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+		jwk{Kty: "EC", Kid: "ec", Crv: "P-256", X: b64(ecKey.X.Bytes()), Y: b64(ecKey.Y.Bytes())},
+		jwk{Kty: "OKP", Kid: "ed", Crv: "Ed25519", X: b64(edPub)},
+	)
+
+	var conf model.Config
+	conf.SigninKey = "signkey"
+	conf.AuthKey = "authkey"
+	conf.JWKS.File = path
+	conf.JWKS.Issuer = "haaukins"
+	conf.JWKS.Audience = "haaukins-store"
+	a, err := newAuthenticator(&conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid := jwt.MapClaims{"iss": "haaukins", "aud": "haaukins-store", "exp": time.Now().Add(time.Hour).Unix()}
+
+	tt := []struct {
+		name string
+		ctx  context.Context
+		err  bool
+	}{
+		{name: "RS256", ctx: tokenContext(t, jwt.SigningMethodRS256, rsaKey, "rsa", valid)},
+		{name: "ES256", ctx: tokenContext(t, jwt.SigningMethodES256, ecKey, "ec", valid)},
+		{name: "EdDSA", ctx: tokenContext(t, jwt.SigningMethodEdDSA, edKey, "ed", valid)},
+		{name: "EdDSA without kid", ctx: tokenContext(t, jwt.SigningMethodEdDSA, edKey, "", valid)},
+		{name: "HMAC", ctx: tokenContext(t, jwt.SigningMethodHS256, []byte("signkey"), "", jwt.MapClaims{AUTH_KEY: "authkey"})},
+		{name: "Unknown key", ctx: tokenContext(t, jwt.SigningMethodRS256, otherRSAKey, "rsa", valid), err: true},
+		{name: "Unsupported algorithm", ctx: tokenContext(t, jwt.SigningMethodRS512, rsaKey, "rsa", valid), err: true},
+		{name: "Wrong issuer", ctx: tokenContext(t, jwt.SigningMethodRS256, rsaKey, "rsa",
+			jwt.MapClaims{"iss": "someone", "aud": "haaukins-store"}), err: true},
+		{name: "Wrong audience", ctx: tokenContext(t, jwt.SigningMethodRS256, rsaKey, "rsa",
+			jwt.MapClaims{"iss": "haaukins", "aud": "other"}), err: true},
+		{name: "Expired", ctx: tokenContext(t, jwt.SigningMethodES256, ecKey, "ec",
+			jwt.MapClaims{"iss": "haaukins", "aud": "haaukins-store", "exp": time.Now().Add(-time.Hour).Unix()}), err: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := a.AuthenticateContext(tc.ctx)
+			if tc.err && err == nil {
+				t.Fatalf("expected error, but received none")
+			}
+			if !tc.err && err != nil {
+				t.Fatalf("expected no error, but received: %s", err)
+			}
+		})
+	}
+
+	t.Run("Reload on change", func(t *testing.T) {
+		writeKeySet(t, path, jwk{Kty: "RSA", Kid: "rsa2", N: b64(otherRSAKey.N.Bytes()), E: b64(big.NewInt(int64(otherRSAKey.E)).Bytes())})
+		// make sure modification time differs on file systems with coarse timestamps
+		later := time.Now().Add(time.Second)
+		if err := os.Chtimes(path, later, later); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := a.AuthenticateContext(tokenContext(t, jwt.SigningMethodRS256, otherRSAKey, "rsa2", valid)); err != nil {
+			t.Fatalf("expected no error after reload, but received: %s", err)
+		}
+		if _, err := a.AuthenticateContext(tokenContext(t, jwt.SigningMethodRS256, rsaKey, "rsa", valid)); err == nil {
+			t.Fatalf("expected removed key to be rejected")
+		}
+	})
+
+	t.Run("HMAC disabled", func(t *testing.T) {
+		conf.JWKS.DisableHMAC = true
+		a, err := newAuthenticator(&conf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx := tokenContext(t, jwt.SigningMethodHS256, []byte("signkey"), "", jwt.MapClaims{AUTH_KEY: "authkey"})
+		if _, err := a.AuthenticateContext(ctx); err == nil {
+			t.Fatalf("expected HMAC token to be rejected")
+		}
+	})
+}
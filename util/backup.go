@@ -0,0 +1,185 @@
+package util
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// BackupVersion is increased whenever contents of the backup change incompatibly
+	BackupVersion = 1
+
+	// backup file starts with magic, version and flags, which are followed by
+	// gzipped JSON, optionally encrypted, and SHA-256 checksum of everything before it.
+	// Encrypted content starts with the salt of the key and the nonce.
+	backupMagic     = "HSBACKUP"
+	backupEncrypted = byte(1)
+	backupHeaderLen = len(backupMagic) + 2
+
+	backupSaltLen = 16
+	// scrypt parameters, deriving a key takes about 100ms
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+var (
+	CorruptBackupErr   = errors.New("backup file is corrupt, checksum does not match")
+	EncryptedBackupErr = errors.New("backup is encrypted, configure backup.key to restore it")
+)
+
+// WriteBackup dumps every event, team, status change and audit entry of the store to w,
+// backup is encrypted with AES-256-GCM when key is given, using a key derived by scrypt with a random salt
+func WriteBackup(w io.Writer, store database.Store, key string) (model.Backup, error) {
+	b, err := store.Backup()
+	if err != nil {
+		return b, err
+	}
+	b.Version = BackupVersion
+	b.CreatedAt = time.Now().UTC()
+	for i := range b.Events {
+		b.Events[i].Version = ArchiveVersion
+		b.Events[i].ExportedAt = b.CreatedAt
+		b.Events[i].Secrets = true
+	}
+	data, err := encodeBackup(b, key)
+	if err != nil {
+		return b, err
+	}
+	_, err = w.Write(data)
+	return b, err
+}
+
+// RestoreBackup verifies and decrypts the backup read from r, and writes it into the store, which should be empty
+func RestoreBackup(r io.Reader, store database.Store, key string) (model.Backup, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return model.Backup{}, err
+	}
+	b, err := decodeBackup(data, key)
+	if err != nil {
+		return b, err
+	}
+	for _, a := range b.Events {
+		if err := validateArchive(a); err != nil {
+			return b, fmt.Errorf("event %s: %v", a.Event.Tag, err)
+		}
+	}
+	return b, store.Restore(b)
+}
+
+func encodeBackup(b model.Backup, key string) ([]byte, error) {
+	var payload bytes.Buffer
+	gz := gzip.NewWriter(&payload)
+	if err := json.NewEncoder(gz).Encode(b); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+
+	header := []byte(backupMagic)
+	header = append(header, byte(BackupVersion), 0)
+	content := payload.Bytes()
+	if key != "" {
+		header[backupHeaderLen-1] = backupEncrypted
+		salt := make([]byte, backupSaltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+		gcm, err := backupCipher(key, salt)
+		if err != nil {
+			return nil, err
+		}
+		nonce := make([]byte, gcm.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, err
+		}
+		// salt is authenticated together with the header
+		aad := append(append([]byte{}, header...), salt...)
+		content = gcm.Seal(append(salt, nonce...), nonce, content, aad)
+	}
+
+	data := append(header, content...)
+	sum := sha256.Sum256(data)
+	return append(data, sum[:]...), nil
+}
+
+func decodeBackup(data []byte, key string) (model.Backup, error) {
+	var b model.Backup
+	if len(data) < backupHeaderLen+sha256.Size || string(data[:len(backupMagic)]) != backupMagic {
+		return b, errors.New("not a backup file")
+	}
+	data, sum := data[:len(data)-sha256.Size], data[len(data)-sha256.Size:]
+	if expected := sha256.Sum256(data); !bytes.Equal(expected[:], sum) {
+		return b, CorruptBackupErr
+	}
+	header, content := data[:backupHeaderLen], data[backupHeaderLen:]
+	if v := int(header[len(backupMagic)]); v < 1 || v > BackupVersion {
+		return b, fmt.Errorf("unsupported backup version %d", v)
+	}
+
+	flags := header[backupHeaderLen-1]
+	if flags&^backupEncrypted != 0 {
+		return b, fmt.Errorf("unsupported backup flags %d", flags)
+	}
+	if flags&backupEncrypted != 0 {
+		if key == "" {
+			return b, EncryptedBackupErr
+		}
+		if len(content) < backupSaltLen {
+			return b, CorruptBackupErr
+		}
+		salt := content[:backupSaltLen]
+		content = content[backupSaltLen:]
+		aad := append(append([]byte{}, header...), salt...)
+		gcm, err := backupCipher(key, salt)
+		if err != nil {
+			return b, err
+		}
+		if len(content) < gcm.NonceSize() {
+			return b, CorruptBackupErr
+		}
+		nonce := content[:gcm.NonceSize()]
+		if content, err = gcm.Open(nil, nonce, content[gcm.NonceSize():], aad); err != nil {
+			return b, errors.New("unable to decrypt backup, backup.key is wrong")
+		}
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(content))
+	if err != nil {
+		return b, err
+	}
+	if err := json.NewDecoder(gz).Decode(&b); err != nil {
+		return b, fmt.Errorf("invalid backup: %v", err)
+	}
+	return b, nil
+}
+
+// backupCipher derives AES-256 key from configured key with scrypt and the salt,
+// so passphrases are expensive to guess
+func backupCipher(key string, salt []byte) (cipher.AEAD, error) {
+	k, err := scrypt.Key([]byte(key), salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,95 @@
+package util
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/aau-network-security/haaukins-store/model"
+)
+
+type backupStore struct {
+	exportStore
+	restored []model.Backup
+}
+
+func (s *backupStore) Backup() (model.Backup, error) {
+	a, _ := s.ExportEvent("test")
+	return model.Backup{Events: []model.EventArchive{a}, AuditLog: []model.AuditEntry{{Method: "AddEvent", EventTag: "test"}}}, nil
+}
+
+func (s *backupStore) Restore(b model.Backup) error {
+	s.restored = append(s.restored, b)
+	return nil
+}
+
+func TestBackup(t *testing.T) {
+	tt := []struct {
+		name       string
+		key        string
+		restoreKey string
+		corrupt    bool
+		err        bool
+	}{
+		{name: "Plain"},
+		{name: "Encrypted", key: "backup-key", restoreKey: "backup-key"},
+		{name: "Encrypted without key", key: "backup-key", err: true},
+		{name: "Wrong key", key: "backup-key", restoreKey: "wrong-key", err: true},
+		{name: "Corrupt", corrupt: true, err: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &backupStore{}
+			var buf bytes.Buffer
+			if _, err := WriteBackup(&buf, store, tc.key); err != nil {
+				t.Fatalf("WriteBackup() error = %v", err)
+			}
+			data := buf.Bytes()
+			if tc.key != "" && bytes.Contains(data, []byte("s3cr3t")) {
+				t.Errorf("expected secrets to be encrypted")
+			}
+			if tc.corrupt {
+				data[len(data)/2] ^= 0xff
+			}
+
+			b, err := RestoreBackup(bytes.NewReader(data), store, tc.restoreKey)
+			if (err != nil) != tc.err {
+				t.Fatalf("RestoreBackup() error = %v, want error %t", err, tc.err)
+			}
+			if err != nil {
+				if len(store.restored) != 0 {
+					t.Errorf("expected nothing to be restored")
+				}
+				return
+			}
+			if b.Version != BackupVersion || len(b.Events) != 1 || b.Events[0].Event.SecretKey != "s3cr3t" || len(b.AuditLog) != 1 {
+				t.Errorf("unexpected backup %+v", b)
+			}
+			if len(store.restored) != 1 {
+				t.Errorf("expected backup to be restored")
+			}
+		})
+	}
+
+	if _, err := RestoreBackup(bytes.NewReader([]byte("not a backup file at all, definitely not")), &backupStore{}, ""); err == nil {
+		t.Errorf("expected error on invalid file")
+	}
+}
+
+// header flags could not change how the key is derived
+func TestRestoreUnknownFlags(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := WriteBackup(&buf, &backupStore{}, "backup-key"); err != nil {
+		t.Fatalf("WriteBackup() error = %v", err)
+	}
+	data := buf.Bytes()[:buf.Len()-sha256.Size]
+	data[backupHeaderLen-1] |= 2
+	sum := sha256.Sum256(data)
+	data = append(data, sum[:]...)
+
+	store := &backupStore{}
+	if _, err := RestoreBackup(bytes.NewReader(data), store, "backup-key"); err == nil || len(store.restored) != 0 {
+		t.Errorf("expected backup with unknown flags to be rejected")
+	}
+}
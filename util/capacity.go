@@ -0,0 +1,147 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+const dayFormat = "2006-01-02"
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// capacityBudget is the number of VMs the cluster could run on a day,
+// limit of a date takes precedence over limit of a weekday,
+// which takes precedence over the cluster limit
+type capacityBudget struct {
+	limit    int // zero means unlimited
+	weekdays map[time.Weekday]int
+	days     map[string]int
+	reject   bool // reject bookings which exceed the budget
+}
+
+func newCapacityBudget(conf *model.Config) *capacityBudget {
+	b := &capacityBudget{
+		limit:    conf.Capacity.VMLimit,
+		weekdays: map[time.Weekday]int{},
+		days:     map[string]int{},
+		reject:   conf.Capacity.RejectOverbooking,
+	}
+	for d, l := range conf.Capacity.Weekdays {
+		b.weekdays[weekdays[strings.ToLower(d)]] = l
+	}
+	for d, l := range conf.Capacity.Days {
+		b.days[d] = l
+	}
+	return b
+}
+
+// limitAt returns the limit of given day, false when the day is unlimited
+func (b *capacityBudget) limitAt(day time.Time) (int, bool) {
+	if l, ok := b.days[day.Format(dayFormat)]; ok {
+		return l, true
+	}
+	if l, ok := b.weekdays[day.Weekday()]; ok {
+		return l, true
+	}
+	return b.limit, b.limit > 0
+}
+
+// bookingDays parses and validates start and expected finish of a booking
+func bookingDays(in *pb.CheckBookingCapacityRequest) (time.Time, time.Time, error) {
+	start, err := time.Parse(database.TimeFormat, in.StartTime)
+	if err != nil {
+		return start, start, fmt.Errorf("invalid start time: %v", err)
+	}
+	finish, err := time.Parse(database.TimeFormat, in.ExpectedFinishTime)
+	if err != nil {
+		return start, finish, fmt.Errorf("invalid expected finish time: %v", err)
+	}
+	if finish.Before(start) {
+		return start, finish, fmt.Errorf("expected finish time %s is before start time %s", in.ExpectedFinishTime, in.StartTime)
+	}
+	if in.Capacity < 0 {
+		return start, finish, fmt.Errorf("capacity cannot be negative")
+	}
+	return start, finish, nil
+}
+
+// check projects load of the days of a booking with given capacity
+// and reports whether it fits into the budget on every day
+func (b *capacityBudget) check(store database.Store, in *pb.CheckBookingCapacityRequest) (*pb.CheckBookingCapacityResponse, error) {
+	start, finish, err := bookingDays(in)
+	if err != nil {
+		return nil, err
+	}
+	load, err := store.GetProjectedLoad(start, finish)
+	if err != nil {
+		return nil, err
+	}
+	return b.fit(load, in.Capacity), nil
+}
+
+// fit adds capacity of a booking to projected load of its days and compares it to the budget
+func (b *capacityBudget) fit(load []model.UsagePoint, capacity int32) *pb.CheckBookingCapacityResponse {
+	resp := &pb.CheckBookingCapacityResponse{Fits: true}
+	for _, p := range load {
+		vms := p.VMs + capacity
+		limit, limited := b.limitAt(p.Time)
+		if vms > resp.PeakLoad || resp.PeakDay == "" {
+			resp.PeakLoad = vms
+			resp.PeakDay = p.Time.Format(dayFormat)
+			resp.Limit = 0
+			if limited {
+				resp.Limit = int32(limit)
+			}
+		}
+		if limited && int(vms) > limit && resp.Fits {
+			resp.Fits = false
+			resp.ExceededDay = p.Time.Format(dayFormat)
+		}
+	}
+	return resp
+}
+
+func (s server) CheckBookingCapacity(ctx context.Context, in *pb.CheckBookingCapacityRequest) (*pb.CheckBookingCapacityResponse, error) {
+	resp, err := s.capacity.check(s.store, in)
+	if err != nil {
+		log.Printf("ERR: Error Check Booking Capacity %s", err.Error())
+		return &pb.CheckBookingCapacityResponse{ErrorMessage: err.Error()}, nil
+	}
+	return resp, nil
+}
+
+// addEvent adds the event, when the budget rejects overbooking, booked events are
+// checked against it and added in one transaction, so concurrent bookings could not overbook
+func (s server) addEvent(in *pb.AddEventRequest) (string, error) {
+	if !s.capacity.reject || in.Status != int32(database.Booked) {
+		return s.store.AddEvent(in)
+	}
+	if _, _, err := bookingDays(&pb.CheckBookingCapacityRequest{
+		StartTime:          in.StartTime,
+		ExpectedFinishTime: in.ExpectedFinishTime,
+		Capacity:           in.Capacity,
+	}); err != nil {
+		return "", err
+	}
+	return s.store.AddBooking(in, func(load []model.UsagePoint) error {
+		if resp := s.capacity.fit(load, in.Capacity); !resp.Fits {
+			return fmt.Errorf("booking exceeds capacity of the cluster on %s", resp.ExceededDay)
+		}
+		return nil
+	})
+}
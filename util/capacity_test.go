@@ -0,0 +1,142 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+// loadStore returns fixed projected load for days starting from 2021-06-04 (Friday)
+type loadStore struct {
+	database.Store
+	load  []int32
+	added []string
+}
+
+func (s *loadStore) GetProjectedLoad(from, to time.Time) ([]model.UsagePoint, error) {
+	var points []model.UsagePoint
+	first := time.Date(2021, 6, 4, 0, 0, 0, 0, time.UTC)
+	for i, vms := range s.load {
+		day := first.AddDate(0, 0, i)
+		if day.Before(from.Truncate(24*time.Hour)) || day.After(to) {
+			continue
+		}
+		points = append(points, model.UsagePoint{Time: day, VMs: vms})
+	}
+	return points, nil
+}
+
+func (s *loadStore) AddEvent(in *pb.AddEventRequest) (string, error) {
+	s.added = append(s.added, in.Tag)
+	return "Event correctly added!", nil
+}
+
+// AddBooking counts added bookings into the load, like the store does in its transaction
+func (s *loadStore) AddBooking(in *pb.AddEventRequest, fits func([]model.UsagePoint) error) (string, error) {
+	start, _ := time.Parse(database.TimeFormat, in.StartTime)
+	finish, _ := time.Parse(database.TimeFormat, in.ExpectedFinishTime)
+	load, _ := s.GetProjectedLoad(start, finish)
+	if err := fits(load); err != nil {
+		return "", err
+	}
+	for i := range s.load {
+		s.load[i] += in.Capacity
+	}
+	return s.AddEvent(in)
+}
+
+func TestCheckBookingCapacity(t *testing.T) {
+	var conf model.Config
+	conf.Capacity.VMLimit = 100
+	conf.Capacity.Weekdays = map[string]int{"Saturday": 40}
+	conf.Capacity.Days = map[string]int{"2021-06-07": 60}
+
+	store := &loadStore{load: []int32{50, 30, 10, 20}}
+	s := server{store: store, capacity: newCapacityBudget(&conf)}
+
+	tt := []struct {
+		name     string
+		start    string
+		finish   string
+		capacity int32
+		peak     int32
+		peakDay  string
+		limit    int32
+		fits     bool
+		exceeded string
+		err      bool
+	}{
+		{name: "Fits", start: "2021-06-04 10:00:00", finish: "2021-06-04 18:00:00", capacity: 40, peak: 90, peakDay: "2021-06-04", limit: 100, fits: true},
+		{name: "Weekday limit", start: "2021-06-04 10:00:00", finish: "2021-06-05 18:00:00", capacity: 20, peak: 70, peakDay: "2021-06-04", limit: 100, exceeded: "2021-06-05"},
+		{name: "Date limit", start: "2021-06-06 10:00:00", finish: "2021-06-07 18:00:00", capacity: 45, peak: 65, peakDay: "2021-06-07", limit: 60, exceeded: "2021-06-07"},
+		{name: "Reversed", start: "2021-06-07 10:00:00", finish: "2021-06-06 18:00:00", err: true},
+		{name: "Invalid time", start: "2021-06-07", finish: "2021-06-08 18:00:00", err: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := s.CheckBookingCapacity(context.Background(), &pb.CheckBookingCapacityRequest{
+				StartTime:          tc.start,
+				ExpectedFinishTime: tc.finish,
+				Capacity:           tc.capacity,
+			})
+			if err != nil {
+				t.Fatalf("expected no error, but received: %s", err)
+			}
+			if tc.err {
+				if resp.ErrorMessage == "" {
+					t.Fatalf("expected error message, but received none")
+				}
+				return
+			}
+			if resp.ErrorMessage != "" {
+				t.Fatalf("expected no error message, but received: %s", resp.ErrorMessage)
+			}
+			if resp.PeakLoad != tc.peak || resp.PeakDay != tc.peakDay || resp.Limit != tc.limit {
+				t.Errorf("expected peak %d on %s with limit %d, but received %d on %s with limit %d",
+					tc.peak, tc.peakDay, tc.limit, resp.PeakLoad, resp.PeakDay, resp.Limit)
+			}
+			if resp.Fits != tc.fits || resp.ExceededDay != tc.exceeded {
+				t.Errorf("expected fits %v exceeded on %q, but received fits %v exceeded on %q", tc.fits, tc.exceeded, resp.Fits, resp.ExceededDay)
+			}
+		})
+	}
+}
+
+func TestRejectOverbooking(t *testing.T) {
+	var conf model.Config
+	conf.Capacity.VMLimit = 100
+	conf.Capacity.RejectOverbooking = true
+
+	store := &loadStore{load: []int32{80}}
+	s := server{store: store, capacity: newCapacityBudget(&conf)}
+
+	booking := func(tag string, status int32) *pb.AddEventRequest {
+		return &pb.AddEventRequest{Tag: tag, Status: status, Capacity: 30, StartTime: "2021-06-04 10:00:00", ExpectedFinishTime: "2021-06-04 18:00:00"}
+	}
+
+	resp, _ := s.AddEvent(context.Background(), booking("booked", int32(database.Booked)))
+	if resp.ErrorMessage == "" {
+		t.Fatalf("expected booking exceeding capacity to be rejected")
+	}
+	// bookings which fit are counted against the following ones
+	store.load[0] = 50
+	if resp, _ := s.AddEvent(context.Background(), booking("first", int32(database.Booked))); resp.ErrorMessage != "" {
+		t.Fatalf("expected booking to fit, but received: %s", resp.ErrorMessage)
+	}
+	if resp, _ := s.AddEvent(context.Background(), booking("second", int32(database.Booked))); resp.ErrorMessage == "" {
+		t.Fatalf("expected second booking exceeding capacity to be rejected")
+	}
+	// running events are not bookings, they are not rejected
+	resp, _ = s.AddEvent(context.Background(), booking("running", int32(database.Running)))
+	if resp.ErrorMessage != "" {
+		t.Fatalf("expected no error message, but received: %s", resp.ErrorMessage)
+	}
+	if len(store.added) != 2 || store.added[0] != "first" || store.added[1] != "running" {
+		t.Fatalf("expected only the first booking and running event to be added, but added %v", store.added)
+	}
+}
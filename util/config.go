@@ -0,0 +1,417 @@
+package util
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// EnvPrefix is the prefix of environment variables which override configuration,
+	// e.g. db.host is overridden by HAAUKINS_STORE_DB_HOST
+	EnvPrefix = "HAAUKINS_STORE_"
+
+	defaultHost      = ":50051"
+	defaultSigninKey = "dev-env"
+	defaultAuthKey   = "development-environment"
+	defaultDBPort    = 5432
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// ConfigErrors is the list of all problems found in configuration
+type ConfigErrors []error
+
+func (e ConfigErrors) Error() string {
+	var msgs []string
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("%d configuration errors:\n  - %s", len(e), strings.Join(msgs, "\n  - "))
+}
+
+// ConfigOverrides are configuration values given on command line, by configuration key
+type ConfigOverrides map[string]string
+
+// ConfigFlags defines a flag on fs for every configuration key which could be overridden,
+// e.g. -db.host, values of the flags which are set are collected in returned overrides
+func ConfigFlags(fs *flag.FlagSet) ConfigOverrides {
+	overrides := ConfigOverrides{}
+	walkConfig(reflect.ValueOf(&model.Config{}).Elem(), "", func(key string, field reflect.Value) {
+		f := &overrideFlag{key: key, overrides: overrides, isBool: field.Kind() == reflect.Bool}
+		fs.Var(f, key, fmt.Sprintf("overrides %s in configuration file (env: %s)", key, envName(key)))
+	})
+	return overrides
+}
+
+// overrideFlag collects value of a configuration key given on command line
+type overrideFlag struct {
+	key       string
+	overrides ConfigOverrides
+	isBool    bool
+}
+
+func (f *overrideFlag) String() string {
+	if f == nil || f.overrides == nil {
+		return ""
+	}
+	return f.overrides[f.key]
+}
+
+func (f *overrideFlag) Set(v string) error {
+	f.overrides[f.key] = v
+	return nil
+}
+
+// IsBoolFlag allows boolean keys to be given without value, e.g. -tls.enabled
+func (f *overrideFlag) IsBoolFlag() bool {
+	return f.isBool
+}
+
+// NewConfigFromFile reads configuration file, overrides its values by environment variables
+// and then by flags, reads secrets from files, sets defaults and validates the result.
+// Configuration file is optional when everything is given by environment or flags.
+func NewConfigFromFile(path string, flags ConfigOverrides) (*model.Config, error) {
+	var c model.Config
+	f, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		log.Printf("Configuration file %s not found, using environment and flags", path)
+	case err != nil:
+		return nil, err
+	default:
+		if err := yaml.Unmarshal(f, &c); err != nil {
+			return nil, err
+		}
+	}
+
+	var errs ConfigErrors
+	walkConfig(reflect.ValueOf(&c).Elem(), "", func(key string, field reflect.Value) {
+		if v, ok := os.LookupEnv(envName(key)); ok {
+			if err := setConfigValue(field, v); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %v", envName(key), err))
+			}
+		}
+		if v, ok := flags[key]; ok {
+			if err := setConfigValue(field, v); err != nil {
+				errs = append(errs, fmt.Errorf("-%s: %v", key, err))
+			}
+		}
+	})
+
+	errs = append(errs, readSecrets(&c)...)
+	setDefaults(&c)
+	errs = append(errs, validateConfig(&c)...)
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return &c, nil
+}
+
+// readSecrets reads secret values from files, e.g. Docker secrets,
+// when both value and file are given, file takes precedence
+func readSecrets(c *model.Config) ConfigErrors {
+	var errs ConfigErrors
+	secrets := []struct {
+		key   string
+		file  string
+		value *string
+	}{
+		{key: "auth-key-file", file: c.AuthKeyFile, value: &c.AuthKey},
+		{key: "signin-key-file", file: c.SigninKeyFile, value: &c.SigninKey},
+		{key: "db.pass-file", file: c.DB.PassFile, value: &c.DB.Pass},
+		{key: "backup.key-file", file: c.Backup.KeyFile, value: &c.Backup.Key},
+	}
+	for _, s := range secrets {
+		if s.file == "" {
+			continue
+		}
+		content, err := ioutil.ReadFile(s.file)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", s.key, err))
+			continue
+		}
+		*s.value = strings.TrimSpace(string(content))
+	}
+	return errs
+}
+
+func setDefaults(c *model.Config) {
+	if c.Host == "" {
+		log.Println("Host not provided in the configuration file")
+		c.Host = defaultHost
+	}
+
+	if c.SigninKey == "" {
+		log.Println("SigninKey not provided in the configuration file")
+		c.SigninKey = defaultSigninKey
+	}
+
+	if c.AuthKey == "" {
+		log.Println("AuthKey not provided in the configuration file")
+		c.AuthKey = defaultAuthKey
+	}
+
+	if c.DB.Port == 0 {
+		c.DB.Port = defaultDBPort
+	}
+
+	if c.ShutdownTimeout <= 0 {
+		c.ShutdownTimeout = defaultDrainTimeout
+	}
+}
+
+func validateConfig(c *model.Config) ConfigErrors {
+	var errs ConfigErrors
+
+	for _, a := range []struct{ key, value string }{
+		{"host", c.Host},
+		{"gateway.host", c.Gateway.Host},
+		{"metrics.host", c.Metrics.Host},
+	} {
+		if a.value == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(a.value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid address %q", a.key, a.value))
+		}
+	}
+
+	// connection string contains every parameter of the database
+	if c.DB.ConnectionString == "" {
+		for _, r := range []struct{ key, value string }{
+			{"db.host", c.DB.Host},
+			{"db.user", c.DB.User},
+			{"db.pass", c.DB.Pass},
+			{"db.db_name", c.DB.Name},
+		} {
+			if r.value == "" {
+				errs = append(errs, fmt.Errorf("%s: DB parameter missing", r.key))
+			}
+		}
+	}
+
+	// connection string is passed to the driver as it is
+	if c.DB.ConnectionString != "" && c.DB.StatementTimeout > 0 {
+		errs = append(errs, errors.New("db.statement-timeout: Statement timeout is not applied with connection-string, add statement_timeout parameter to the connection string instead"))
+	}
+
+	switch c.DB.SSLMode {
+	case "", "disable", "require", "verify-ca", "verify-full":
+	default:
+		errs = append(errs, fmt.Errorf("db.sslmode: Unknown SSL mode %q, use disable, require, verify-ca or verify-full", c.DB.SSLMode))
+	}
+
+	if (c.DB.SSLCert == "") != (c.DB.SSLKey == "") {
+		errs = append(errs, errors.New("db.sslcert: Provide both sslcert and sslkey for client certificate"))
+	}
+
+	if c.DB.MaxOpenConns < 0 || c.DB.MaxIdleConns < 0 || c.DB.ConnMaxLifetime < 0 || c.DB.ConnMaxIdleTime < 0 || c.DB.StatementTimeout < 0 {
+		errs = append(errs, errors.New("db: Connection pool settings and statement timeout cannot be negative"))
+	}
+
+	// background workers hold their leader locks on a connection of the pool
+	if workers := backgroundWorkers(c); workers > 0 && c.DB.MaxOpenConns == 1 {
+		errs = append(errs, fmt.Errorf("db.max-open-conns: %d background workers hold a connection for their locks, allow at least 2 connections", workers))
+	}
+
+	r := c.DB.Retry
+	if r.InitialInterval < 0 || r.MaxInterval < 0 || r.StartupTimeout < 0 || r.ReadAttempts < 0 {
+		errs = append(errs, errors.New("db.retry: Retry intervals, startup timeout and read attempts cannot be negative"))
+	}
+
+	if c.TLS.Enabled {
+		if c.TLS.CAFile == "" || c.TLS.CertKey == "" || c.TLS.CertFile == "" {
+			errs = append(errs, errors.New("tls: Provide certfile, certkey and cafile when TLS is enabled"))
+		}
+	}
+
+	if !validAuthMode(c.ClientCerts.Mode) {
+		errs = append(errs, fmt.Errorf("client-certs.mode: Unknown client certificate mode %q", c.ClientCerts.Mode))
+	}
+
+	if c.ClientCerts.Mode != "" && c.ClientCerts.Mode != JWTAuthMode && !c.TLS.Enabled {
+		errs = append(errs, errors.New("client-certs.mode: Client certificate identities require TLS to be enabled"))
+	}
+
+	if c.RateLimit.Rate < 0 || c.RateLimit.Burst < 0 || c.RateLimit.MaxInFlight < 0 {
+		errs = append(errs, errors.New("rate-limit: Rate limits cannot be negative"))
+	}
+	for m, l := range c.RateLimit.Methods {
+		if l.Rate < 0 || l.Burst < 0 || l.MaxInFlight < 0 {
+			errs = append(errs, fmt.Errorf("rate-limit.methods.%s: Rate limits cannot be negative", m))
+		}
+	}
+
+	switch c.Scheduler.Expired {
+	case "", MarkExpired, CloseExpired:
+	default:
+		errs = append(errs, fmt.Errorf("scheduler.expired: Unknown action %q, use mark or close", c.Scheduler.Expired))
+	}
+	if c.Scheduler.Interval < 0 || c.Scheduler.GracePeriod < 0 {
+		errs = append(errs, errors.New("scheduler: Interval and grace period cannot be negative"))
+	}
+
+	if c.InactiveTeams.Interval < 0 || c.InactiveTeams.InactiveFor < 0 {
+		errs = append(errs, errors.New("inactive-teams: Interval and inactive for cannot be negative"))
+	}
+	if c.InactiveTeams.Enabled && c.InactiveTeams.InactiveFor == 0 {
+		errs = append(errs, errors.New("inactive-teams.inactive-for: Provide inactive for when inactive teams policy is enabled"))
+	}
+	if w := c.InactiveTeams.Webhook; w != "" {
+		if u, err := url.Parse(w); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("inactive-teams.webhook: Invalid webhook URL %q", w))
+		}
+	}
+
+	if c.Retention.AnonymizeAfter < 0 || c.Retention.Interval < 0 {
+		errs = append(errs, errors.New("retention: Anonymize after and interval cannot be negative"))
+	}
+
+	if c.Capacity.VMLimit < 0 {
+		errs = append(errs, errors.New("capacity.vm-limit: VM limit cannot be negative"))
+	}
+	for d, l := range c.Capacity.Weekdays {
+		if _, ok := weekdays[strings.ToLower(d)]; !ok {
+			errs = append(errs, fmt.Errorf("capacity.weekdays.%s: Unknown weekday", d))
+		}
+		if l < 0 {
+			errs = append(errs, fmt.Errorf("capacity.weekdays.%s: VM limit cannot be negative", d))
+		}
+	}
+	for d, l := range c.Capacity.Days {
+		if _, err := time.Parse(dayFormat, d); err != nil {
+			errs = append(errs, fmt.Errorf("capacity.days.%s: Day should be in %s format", d, dayFormat))
+		}
+		if l < 0 {
+			errs = append(errs, fmt.Errorf("capacity.days.%s: VM limit cannot be negative", d))
+		}
+	}
+	if c.Capacity.RejectOverbooking && c.Capacity.VMLimit == 0 && len(c.Capacity.Weekdays) == 0 && len(c.Capacity.Days) == 0 {
+		errs = append(errs, errors.New("capacity.reject-overbooking: Configure a VM limit to reject overbooking"))
+	}
+
+	if c.JWKS.DisableHMAC && c.JWKS.File == "" {
+		errs = append(errs, errors.New("jwks.disable-hmac: HMAC tokens cannot be disabled without a JWKS file"))
+	}
+
+	return errs
+}
+
+// backgroundWorkers counts enabled workers which run on a single server at a time
+func backgroundWorkers(c *model.Config) int {
+	n := 0
+	for _, enabled := range []bool{c.Scheduler.Enabled, c.InactiveTeams.Enabled, c.Retention.AnonymizeAfter > 0} {
+		if enabled {
+			n++
+		}
+	}
+	return n
+}
+
+// walkConfig calls fn for every configuration key which has a scalar value
+// or a list of scalars, keys are yaml names of the fields joined by dots.
+// maps and lists of structs could only be set in configuration file.
+func walkConfig(v reflect.Value, prefix string, fn func(key string, field reflect.Value)) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("yaml"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		field := v.Field(i)
+		if f.Anonymous && name == "" {
+			walkConfig(field, prefix, fn)
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+		key := prefix + name
+
+		switch {
+		case f.Type.Kind() == reflect.Struct:
+			walkConfig(field, key+".", fn)
+		case f.Type.Kind() == reflect.Map:
+		case f.Type.Kind() == reflect.Slice && f.Type.Elem().Kind() == reflect.Struct:
+		default:
+			fn(key, field)
+		}
+	}
+}
+
+func envName(key string) string {
+	return EnvPrefix + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(key))
+}
+
+// setConfigValue parses given string into field according to its type,
+// lists are comma separated
+func setConfigValue(field reflect.Value, v string) error {
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(v)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(v, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(v, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(v, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Slice:
+		var items []string
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		list := reflect.MakeSlice(field.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := setConfigValue(list.Index(i), item); err != nil {
+				return err
+			}
+		}
+		field.Set(list)
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
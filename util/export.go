@@ -0,0 +1,272 @@
+package util
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ArchiveVersion is increased whenever fields of the archive change incompatibly
+	ArchiveVersion = 1
+
+	JSONFormat  = "json"
+	TarGzFormat = "tar.gz"
+
+	archiveFile = "event.json"
+)
+
+// bundleFile is a file in the tar.gz archive
+type bundleFile struct {
+	name    string
+	content []byte
+}
+
+// ExportEvent writes the archive of the event with given tag to w in given format,
+// secret key of the event and password hashes of teams are left out unless includeSecrets is set
+func ExportEvent(w io.Writer, store database.Store, tag, format string, includeSecrets bool) error {
+	if format == "" {
+		format = JSONFormat
+	}
+	if format != JSONFormat && format != TarGzFormat {
+		return fmt.Errorf("invalid format %q, use %s or %s", format, JSONFormat, TarGzFormat)
+	}
+
+	a, err := store.ExportEvent(tag)
+	if err != nil {
+		return err
+	}
+	a.Version = ArchiveVersion
+	a.ExportedAt = time.Now().UTC()
+	a.Secrets = includeSecrets
+	if !includeSecrets {
+		a.Event.SecretKey = ""
+		for i := range a.Teams {
+			a.Teams[i].Password = ""
+		}
+	}
+
+	if format == TarGzFormat {
+		return writeTarGz(w, a)
+	}
+	return writeJSON(w, a)
+}
+
+// ArchiveFileName is the suggested name of the archive file of an event
+func ArchiveFileName(tag, format string) string {
+	if format == "" {
+		format = JSONFormat
+	}
+	return fmt.Sprintf("%s.%s", tag, format)
+}
+
+func (s server) ExportEvent(ctx context.Context, in *pb.ExportEventRequest) (*pb.ExportEventResponse, error) {
+	if in.IncludeSecrets {
+		if id, ok := IdentityFromContext(ctx); !ok || !id.HasScope(AdminScope) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s scope is required to export secrets", AdminScope)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := ExportEvent(&buf, s.store, in.EventTag, in.Format, in.IncludeSecrets); err != nil {
+		log.Printf("ERR: Error Export Event %s : %s", in.EventTag, err.Error())
+		return &pb.ExportEventResponse{ErrorMessage: err.Error()}, nil
+	}
+	log.Printf("Event %s exported", in.EventTag)
+	return &pb.ExportEventResponse{Archive: buf.Bytes(), FileName: ArchiveFileName(in.EventTag, in.Format)}, nil
+}
+
+// ImportEvent reads an archive in either format, validates it and recreates the event
+// with its teams, solves and status history, newTag replaces the archived tag when it is given.
+// It returns the archive which is imported.
+func ImportEvent(r io.Reader, store database.Store, newTag string) (model.EventArchive, error) {
+	a, err := ReadArchive(r)
+	if err != nil {
+		return a, err
+	}
+	if newTag != "" {
+		a.Event.Tag = newTag
+	}
+	if err := validateArchive(a); err != nil {
+		return a, err
+	}
+	return a, store.ImportEvent(a)
+}
+
+// ReadArchive reads an archive which is either JSON or tar.gz bundle written by ExportEvent
+func ReadArchive(r io.Reader) (model.EventArchive, error) {
+	var a model.EventArchive
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(2)
+	if !bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		return a, readJSON(br, &a)
+	}
+
+	gz, err := gzip.NewReader(br)
+	if err != nil {
+		return a, err
+	}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return a, fmt.Errorf("%s is missing in archive", archiveFile)
+		}
+		if err != nil {
+			return a, err
+		}
+		if hdr.Name == archiveFile {
+			return a, readJSON(tr, &a)
+		}
+	}
+}
+
+func readJSON(r io.Reader, a *model.EventArchive) error {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(a); err != nil {
+		return fmt.Errorf("invalid archive: %v", err)
+	}
+	return nil
+}
+
+// validateArchive reports every problem of the archive together
+func validateArchive(a model.EventArchive) error {
+	var errs []string
+	if a.Version < 1 || a.Version > ArchiveVersion {
+		errs = append(errs, fmt.Sprintf("unsupported archive version %d", a.Version))
+	}
+	if a.Event.Tag == "" {
+		errs = append(errs, "event tag is missing")
+	}
+	for _, t := range []string{a.Event.StartedAt, a.Event.ExpectedFinishTime, a.Event.FinishedAt} {
+		if _, err := database.ParseArchiveTime(t); err != nil {
+			errs = append(errs, fmt.Sprintf("event: %v", err))
+		}
+	}
+
+	teams := map[string]bool{}
+	for i, t := range a.Teams {
+		if t.Id == "" {
+			errs = append(errs, fmt.Sprintf("team %d: id is missing", i))
+		} else if teams[t.Id] {
+			errs = append(errs, fmt.Sprintf("team %s: id is duplicated", t.Id))
+		}
+		teams[t.Id] = true
+		for _, v := range []string{t.CreatedAt, t.LastAccess} {
+			if _, err := database.ParseArchiveTime(v); err != nil {
+				errs = append(errs, fmt.Sprintf("team %s: %v", t.Id, err))
+			}
+		}
+		solved := map[string]bool{}
+		for _, c := range t.Solves {
+			if c.Challenge == "" || solved[c.Challenge] {
+				errs = append(errs, fmt.Sprintf("team %s: challenge %q is missing or solved twice", t.Id, c.Challenge))
+			}
+			solved[c.Challenge] = true
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New("invalid archive: " + strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s server) ImportEvent(ctx context.Context, in *pb.ImportEventRequest) (*pb.ImportEventResponse, error) {
+	a, err := ImportEvent(bytes.NewReader(in.Archive), s.store, in.NewTag)
+	if err != nil {
+		log.Printf("ERR: Error Import Event %s : %s", a.Event.Tag, err.Error())
+		return &pb.ImportEventResponse{ErrorMessage: err.Error()}, nil
+	}
+	log.Printf("Event %s imported with %d teams", a.Event.Tag, len(a.Teams))
+	return &pb.ImportEventResponse{EventTag: a.Event.Tag, Teams: int32(len(a.Teams))}, nil
+}
+
+func writeJSON(w io.Writer, a model.EventArchive) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(a)
+}
+
+// writeTarGz writes the JSON archive together with CSV files of event,
+// teams, solves and status history, so they could be opened in spreadsheets
+func writeTarGz(w io.Writer, a model.EventArchive) error {
+	var archive bytes.Buffer
+	if err := writeJSON(&archive, a); err != nil {
+		return err
+	}
+
+	e := a.Event
+	files := []bundleFile{
+		{archiveFile, archive.Bytes()},
+		{"event.csv", csvFile([]string{"tag", "name", "frontends", "exercises", "disabled_exercises", "available", "capacity", "status",
+			"started_at", "expected_finish_time", "finished_at", "created_by", "only_vpn", "secret_key"},
+			[][]string{{e.Tag, e.Name, e.Frontends, e.Exercises, e.DisabledExercises, uitoa(e.Available), uitoa(e.Capacity), itoa(e.Status),
+				e.StartedAt, e.ExpectedFinishTime, e.FinishedAt, e.CreatedBy, strconv.FormatBool(e.OnlyVPN), e.SecretKey}})},
+	}
+
+	var teams, solves, history [][]string
+	for _, t := range a.Teams {
+		teams = append(teams, []string{t.Id, t.Name, t.Email, t.Password, t.CreatedAt, t.LastAccess, strconv.Itoa(len(t.Solves))})
+		for _, s := range t.Solves {
+			solves = append(solves, []string{t.Id, s.Challenge, s.CompletedAt})
+		}
+	}
+	for _, c := range a.StatusHistory {
+		history = append(history, []string{itoa(c.Status), itoa(c.PreviousStatus), c.ChangedAt.Format(database.TimeFormat), c.Source, c.Reason})
+	}
+	files = append(files,
+		bundleFile{"teams.csv", csvFile([]string{"id", "name", "email", "password", "created_at", "last_access", "solves"}, teams)},
+		bundleFile{"solves.csv", csvFile([]string{"team_id", "challenge", "completed_at"}, solves)},
+		bundleFile{"status_history.csv", csvFile([]string{"status", "previous_status", "changed_at", "source", "reason"}, history)},
+	)
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	for _, f := range files {
+		hdr := &tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.content)), ModTime: a.ExportedAt}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := tw.Write(f.content); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func csvFile(header []string, records [][]string) []byte {
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	cw.Write(header)
+	cw.WriteAll(records)
+	return buf.Bytes()
+}
+
+func itoa(i int32) string {
+	return strconv.Itoa(int(i))
+}
+
+func uitoa(i uint) string {
+	return strconv.FormatUint(uint64(i), 10)
+}
@@ -0,0 +1,169 @@
+package util
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type exportStore struct {
+	database.Store
+	imported []model.EventArchive
+}
+
+func (s *exportStore) ExportEvent(tag string) (model.EventArchive, error) {
+	return model.EventArchive{
+		Event: model.ArchivedEvent{Tag: tag, Name: "Test Event", SecretKey: "s3cr3t"},
+		Teams: []model.ArchivedTeam{
+			{Id: "team1", Name: "Team 1", Email: "team1@example.com", Password: "pa55hash",
+				Solves: []model.ArchivedSolve{{Challenge: "ftp", CompletedAt: "2021-06-04 12:00:00"}}},
+		},
+		StatusHistory: []model.ArchivedStatus{
+			{Status: int32(Closed), PreviousStatus: int32(Running), ChangedAt: time.Date(2021, 6, 4, 18, 0, 0, 0, time.UTC), Source: "scheduler"},
+		},
+	}, nil
+}
+
+func (s *exportStore) ImportEvent(a model.EventArchive) error {
+	s.imported = append(s.imported, a)
+	return nil
+}
+
+func TestExportEvent(t *testing.T) {
+	s := server{store: &exportStore{}}
+	admin := NewIdentityContext(context.Background(), &Identity{Name: "admin", Scopes: []string{AdminScope}})
+	reader := NewIdentityContext(context.Background(), &Identity{Name: "reader", Scopes: []string{ReadScope}})
+
+	tt := []struct {
+		name    string
+		ctx     context.Context
+		secrets bool
+		err     codes.Code
+	}{
+		{name: "Without secrets", ctx: reader},
+		{name: "With secrets", ctx: admin, secrets: true},
+		{name: "Secrets require admin", ctx: reader, secrets: true, err: codes.PermissionDenied},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := s.ExportEvent(tc.ctx, &pb.ExportEventRequest{EventTag: "test", IncludeSecrets: tc.secrets})
+			if status.Code(err) != tc.err {
+				t.Fatalf("ExportEvent() error = %v, want %s", err, tc.err)
+			}
+			if err != nil {
+				return
+			}
+			if resp.FileName != "test.json" {
+				t.Errorf("expected file name test.json, got %s", resp.FileName)
+			}
+
+			var a model.EventArchive
+			if err := json.Unmarshal(resp.Archive, &a); err != nil {
+				t.Fatalf("unable to read archive: %v", err)
+			}
+			if a.Version != ArchiveVersion || a.Event.Tag != "test" || len(a.Teams) != 1 || len(a.Teams[0].Solves) != 1 {
+				t.Errorf("unexpected archive %+v", a)
+			}
+			if got := a.Event.SecretKey != "" || a.Teams[0].Password != ""; got != tc.secrets {
+				t.Errorf("expected secrets included to be %t, archive %+v", tc.secrets, a)
+			}
+		})
+	}
+}
+
+func TestExportEventTarGz(t *testing.T) {
+	var buf strings.Builder
+	if err := ExportEvent(&buf, &exportStore{}, "test", TarGzFormat, false); err != nil {
+		t.Fatalf("ExportEvent() error = %v", err)
+	}
+
+	gz, err := gzip.NewReader(strings.NewReader(buf.String()))
+	if err != nil {
+		t.Fatalf("unable to read gzip: %v", err)
+	}
+	files := map[string]string{}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unable to read tar: %v", err)
+		}
+		content, _ := ioutil.ReadAll(tr)
+		files[hdr.Name] = string(content)
+	}
+
+	for _, name := range []string{"event.json", "event.csv", "teams.csv", "solves.csv", "status_history.csv"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("expected %s in archive", name)
+		}
+	}
+	if want := "team_id,challenge,completed_at\nteam1,ftp,2021-06-04 12:00:00\n"; files["solves.csv"] != want {
+		t.Errorf("expected solves.csv %q, got %q", want, files["solves.csv"])
+	}
+	if strings.Contains(files["teams.csv"], "pa55hash") || strings.Contains(files["event.csv"], "s3cr3t") {
+		t.Errorf("expected secrets to be excluded")
+	}
+
+	if err := ExportEvent(&buf, &exportStore{}, "test", "zip", false); err == nil {
+		t.Errorf("expected error on unknown format")
+	}
+}
+
+func TestImportEvent(t *testing.T) {
+	store := &exportStore{}
+	for _, format := range []string{JSONFormat, TarGzFormat} {
+		var buf bytes.Buffer
+		if err := ExportEvent(&buf, store, "test", format, true); err != nil {
+			t.Fatalf("ExportEvent() error = %v", err)
+		}
+		a, err := ImportEvent(&buf, store, "renamed")
+		if err != nil {
+			t.Fatalf("ImportEvent() %s error = %v", format, err)
+		}
+		if a.Event.Tag != "renamed" || a.Event.SecretKey != "s3cr3t" || len(a.Teams) != 1 || a.Teams[0].Solves[0].Challenge != "ftp" {
+			t.Errorf("unexpected imported %s archive %+v", format, a)
+		}
+	}
+	if len(store.imported) != 2 {
+		t.Errorf("expected 2 imported events, got %d", len(store.imported))
+	}
+
+	tt := []struct {
+		name    string
+		archive string
+	}{
+		{name: "Not an archive", archive: "not json"},
+		{name: "Unknown version", archive: `{"version": 2, "event": {"tag": "test"}}`},
+		{name: "Missing tag", archive: `{"version": 1, "event": {}}`},
+		{name: "Invalid time", archive: `{"version": 1, "event": {"tag": "test", "startedAt": "yesterday"}}`},
+		{name: "Duplicated team", archive: `{"version": 1, "event": {"tag": "test"}, "teams": [{"id": "t"}, {"id": "t"}]}`},
+		{name: "Solved twice", archive: `{"version": 1, "event": {"tag": "test"}, "teams": [{"id": "t", "solves": [{"challenge": "ftp"}, {"challenge": "ftp"}]}]}`},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ImportEvent(strings.NewReader(tc.archive), store, ""); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+	if len(store.imported) != 2 {
+		t.Errorf("invalid archives should not be imported")
+	}
+}
@@ -0,0 +1,98 @@
+package util
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	// OpenAPIPath is where OpenAPI document of the gateway is served
+	OpenAPIPath = "/openapi.json"
+
+	gatewayBufferSize = 1024 * 1024
+	// gatewayNetwork is network of the internal gateway connection
+	gatewayNetwork = "bufconn"
+	bearerPrefix   = "Bearer "
+)
+
+// ServeGateway serves REST/JSON gateway of the store on configured address.
+// Requests are passed to an internal gRPC server through an in-memory connection,
+// so authentication, rate limits and audit log apply to them as well.
+func (s server) ServeGateway(ctx context.Context, conf *model.Config) error {
+	lis := bufconn.Listen(gatewayBufferSize)
+	internal := s.GetGRPCServer()
+	pb.RegisterStoreServer(internal, s)
+	go func() {
+		if err := internal.Serve(lis); err != nil {
+			log.Printf("ERR: Error serving internal gateway connection: %s", err.Error())
+		}
+	}()
+
+	conn, err := grpc.DialContext(ctx, "bufconn",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		return err
+	}
+
+	gwMux := runtime.NewServeMux(runtime.WithMetadata(bearerToken))
+	if err := pb.RegisterStoreHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", gwMux)
+	mux.HandleFunc(OpenAPIPath, serveOpenAPI)
+
+	srv := &http.Server{Addr: conf.Gateway.Host, Handler: mux}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), conf.ShutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Printf("ERR: Error draining gateway requests: %s", err.Error())
+		}
+		conn.Close()
+		internal.Stop()
+	}()
+
+	log.Printf("Gateway is listening on %s", conf.Gateway.Host)
+	if s.certs != nil {
+		srv.TLSConfig = s.certs.tlsConfig()
+		err = srv.ListenAndServeTLS("", "")
+	} else {
+		err = srv.ListenAndServe()
+	}
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+// bearerToken passes token in Authorization header
+// as token metadata which is read by Authenticator
+func bearerToken(ctx context.Context, r *http.Request) metadata.MD {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, bearerPrefix) {
+		return nil
+	}
+	return metadata.Pairs("token", strings.TrimSpace(strings.TrimPrefix(auth, bearerPrefix)))
+}
+
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(pb.OpenAPI)
+}
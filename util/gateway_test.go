@@ -0,0 +1,105 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+type eventStore struct {
+	database.Store
+	events []model.Event
+}
+
+func (s *eventStore) GetEvents(*pb.GetEventRequest) ([]model.Event, error) {
+	return s.events, nil
+}
+
+func freeAddress(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func TestGateway(t *testing.T) {
+	var conf model.Config
+	conf.SigninKey = "signkey"
+	conf.AuthKey = "authkey"
+	conf.Gateway.Host = freeAddress(t)
+
+	auth, err := newAuthenticator(&conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := server{
+		store:  &eventStore{events: []model.Event{{Tag: "test", Name: "Test"}}},
+		auth:   auth,
+		limit:  newRateLimiter(&conf),
+		health: newHealthChecker(&pingStore{}),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		if err := s.ServeGateway(ctx, &conf); err != nil {
+			t.Errorf("failed to serve gateway: %v", err)
+		}
+	}()
+
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{AUTH_KEY: "authkey"}).SignedString([]byte("signkey"))
+	base := fmt.Sprintf("http://%s", conf.Gateway.Host)
+
+	get := func(path, token string) (*http.Response, []byte) {
+		req, _ := http.NewRequest(http.MethodGet, base+path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		var resp *http.Response
+		var err error
+		for i := 0; i < 50; i++ {
+			if resp, err = http.DefaultClient.Do(req); err == nil {
+				break
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(resp.Body)
+		return resp, body
+	}
+
+	resp, body := get("/v1/events", token)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status %d: %s", resp.StatusCode, body)
+	}
+	var events pb.GetEventResponse
+	if err := json.Unmarshal(body, &events); err != nil {
+		t.Fatal(err)
+	}
+	if len(events.Events) != 1 || events.Events[0].Tag != "test" {
+		t.Fatalf("unexpected events %s", body)
+	}
+
+	if resp, body := get("/v1/events", ""); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected unauthorized, but received %d: %s", resp.StatusCode, body)
+	}
+
+	if resp, body := get(OpenAPIPath, ""); resp.StatusCode != http.StatusOK || !json.Valid(body) {
+		t.Fatalf("unexpected OpenAPI document response %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,72 @@
+package util
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	healthCheckInterval = 10 * time.Second
+	healthServicePrefix = "/grpc.health.v1.Health/"
+	storeServiceName    = "store.Store"
+)
+
+// healthChecker reports serving status of the store based on database liveness,
+// both overall ("") and store.Store services are updated
+type healthChecker struct {
+	store    database.Store
+	server   *health.Server
+	interval time.Duration
+}
+
+func newHealthChecker(store database.Store) *healthChecker {
+	h := &healthChecker{
+		store:    store,
+		server:   health.NewServer(),
+		interval: healthCheckInterval,
+	}
+	h.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return h
+}
+
+func (h *healthChecker) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	h.server.SetServingStatus("", status)
+	h.server.SetServingStatus(storeServiceName, status)
+}
+
+// check pings the database and updates serving status accordingly
+func (h *healthChecker) check() {
+	if err := h.store.Ping(); err != nil {
+		log.Printf("ERR: Health check failed: %s", err.Error())
+		h.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+		return
+	}
+	h.setStatus(healthpb.HealthCheckResponse_SERVING)
+}
+
+// run checks health periodically until context is cancelled
+func (h *healthChecker) run(ctx context.Context) {
+	h.check()
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.check()
+		}
+	}
+}
+
+// isHealthMethod reports whether given method belongs to health service,
+// which is exempt from authentication
+func isHealthMethod(method string) bool {
+	return strings.HasPrefix(method, healthServicePrefix)
+}
@@ -0,0 +1,66 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type pingStore struct {
+	database.Store
+	err error
+}
+
+func (s *pingStore) Ping() error {
+	return s.err
+}
+
+func TestHealthChecker(t *testing.T) {
+	store := &pingStore{}
+	h := newHealthChecker(store)
+
+	tt := []struct {
+		name string
+		err  error
+		want healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{name: "Database reachable", want: healthpb.HealthCheckResponse_SERVING},
+		{name: "Database unreachable", err: errors.New("connection refused"), want: healthpb.HealthCheckResponse_NOT_SERVING},
+		{name: "Database recovered", want: healthpb.HealthCheckResponse_SERVING},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			store.err = tc.err
+			h.check()
+			for _, service := range []string{"", storeServiceName} {
+				resp, err := h.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if resp.Status != tc.want {
+					t.Fatalf("unexpected status of %q (expected: %s) received: %s", service, tc.want, resp.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestSetNotServing(t *testing.T) {
+	s := server{health: newHealthChecker(&pingStore{})}
+	s.health.check()
+	s.SetNotServing()
+	// database is still reachable, however status should stay NOT_SERVING during shutdown
+	s.health.check()
+
+	resp, err := s.health.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: storeServiceName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("unexpected status (expected: NOT_SERVING) received: %s", resp.Status)
+	}
+}
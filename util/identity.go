@@ -0,0 +1,231 @@
+package util
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	ReadScope  = "read"
+	WriteScope = "write"
+	AdminScope = "admin"
+	AllScopes  = "*"
+
+	JWTAuthMode        = "jwt"
+	CertAuthMode       = "cert"
+	CertAndJWTAuthMode = "cert-and-jwt"
+	CertOrJWTAuthMode  = "cert-or-jwt"
+)
+
+var (
+	MissingCertErr = errors.New("No verified client certificate provided")
+	UnknownCertErr = errors.New("Client certificate is not mapped to any identity")
+)
+
+// methodScopes are scopes required by RPCs, methods which are missing here
+// require the admin scope, so new RPCs are not callable by mistake with a lesser one.
+var methodScopes = map[string]string{
+	"/store.Store/GetEvents":                 ReadScope,
+	"/store.Store/GetEventByUser":            ReadScope,
+	"/store.Store/GetEventTeams":             ReadScope,
+	"/store.Store/ListInactiveTeams":         ReadScope,
+	"/store.Store/GetEventStatus":            ReadScope,
+	"/store.Store/GetEventStatusHistory":     ReadScope,
+	"/store.Store/IsEventExists":             ReadScope,
+	"/store.Store/GetTimeSeries":             ReadScope,
+	"/store.Store/GetUsageTimeSeries":        ReadScope,
+	"/store.Store/CheckBookingCapacity":      ReadScope,
+	"/store.Store/GetEventID":                ReadScope,
+	"/store.Store/ExportEvent":               ReadScope,
+	"/store.Store/GetEventStats":             ReadScope,
+	"/store.Store/GetSolveTimeline":          ReadScope,
+	"/store.Store/AddEvent":                  WriteScope,
+	"/store.Store/AddTeam":                   WriteScope,
+	"/store.Store/DropEvent":                 WriteScope,
+	"/store.Store/SetEventStatus":            WriteScope,
+	"/store.Store/UpdateCloseEvent":          WriteScope,
+	"/store.Store/UpdateTeamSolvedChallenge": WriteScope,
+	"/store.Store/UpdateTeamLastAccess":      WriteScope,
+	"/store.Store/UpdateTeamPassword":        WriteScope,
+	"/store.Store/UpdateExercises":           WriteScope,
+	"/store.Store/DeleteTeam":                WriteScope,
+	"/store.Store/ImportEvent":               WriteScope,
+	"/store.Store/QueryAuditLog":             AdminScope,
+	"/store.Store/EraseTeamData":             AdminScope,
+}
+
+// Identity is the authenticated caller of an RPC
+type Identity struct {
+	Name   string
+	Source string // either jwt or cert
+	Scopes []string
+}
+
+type identityKey struct{}
+
+func NewIdentityContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// IdentityFromContext returns caller identity which is set by the interceptors
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok
+}
+
+// HasScope reports whether identity is granted given scope,
+// admin scope grants every other scope
+func (id *Identity) HasScope(scope string) bool {
+	for _, s := range id.Scopes {
+		if s == scope || s == AllScopes || s == AdminScope {
+			return true
+		}
+	}
+	return false
+}
+
+// CanCall reports whether identity is allowed to call given full method name
+func (id *Identity) CanCall(method string) bool {
+	return id.HasScope(methodScope(method))
+}
+
+func methodScope(method string) string {
+	if scope, ok := methodScopes[method]; ok {
+		return scope
+	}
+	return AdminScope
+}
+
+type certIdentity struct {
+	cn     string
+	san    []string
+	name   string
+	scopes []string
+}
+
+// certAuth derives identity from the verified client certificate of the TLS connection
+type certAuth struct {
+	identities []certIdentity
+}
+
+func newCertAuthenticator(conf *model.Config) *certAuth {
+	a := &certAuth{}
+	for _, i := range conf.ClientCerts.Identities {
+		name := i.Name
+		if name == "" {
+			name = i.CN
+		}
+		a.identities = append(a.identities, certIdentity{cn: i.CN, san: i.SAN, name: name, scopes: i.Scopes})
+	}
+	return a
+}
+
+func (a *certAuth) AuthenticateContext(ctx context.Context) (*Identity, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, MissingCertErr
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, MissingCertErr
+	}
+
+	cert := tlsInfo.State.VerifiedChains[0][0]
+	for _, i := range a.identities {
+		if i.matches(cert) {
+			return &Identity{Name: i.name, Source: CertAuthMode, Scopes: i.scopes}, nil
+		}
+	}
+	return nil, UnknownCertErr
+}
+
+// matches checks subject common name and subject alternative names of certificate,
+// all configured values should be present in the certificate
+func (i certIdentity) matches(cert *x509.Certificate) bool {
+	if i.cn == "" && len(i.san) == 0 {
+		return false
+	}
+	if i.cn != "" && i.cn != cert.Subject.CommonName {
+		return false
+	}
+
+	var names []string
+	names = append(names, cert.DNSNames...)
+	names = append(names, cert.EmailAddresses...)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		names = append(names, uri.String())
+	}
+	for _, san := range i.san {
+		if !contains(names, san) {
+			return false
+		}
+	}
+	return true
+}
+
+// combinedAuth authenticates callers by client certificate and/or JWT according to mode
+type combinedAuth struct {
+	mode string
+	jwt  Authenticator
+	cert Authenticator
+}
+
+func (a *combinedAuth) AuthenticateContext(ctx context.Context) (*Identity, error) {
+	switch a.mode {
+	case CertAuthMode:
+		return a.cert.AuthenticateContext(ctx)
+	case CertAndJWTAuthMode:
+		if _, err := a.jwt.AuthenticateContext(ctx); err != nil {
+			return nil, err
+		}
+		return a.cert.AuthenticateContext(ctx)
+	case CertOrJWTAuthMode:
+		id, err := a.cert.AuthenticateContext(ctx)
+		if err == nil {
+			return id, nil
+		}
+		return a.jwt.AuthenticateContext(ctx)
+	}
+	return a.jwt.AuthenticateContext(ctx)
+}
+
+func validAuthMode(mode string) bool {
+	switch mode {
+	case "", JWTAuthMode, CertAuthMode, CertAndJWTAuthMode, CertOrJWTAuthMode:
+		return true
+	}
+	return false
+}
+
+// scopesFromClaim reads space separated scope claim of the token,
+// tokens without scope claim are granted every scope
+func scopesFromClaim(claim interface{}) []string {
+	scope, ok := claim.(string)
+	if !ok {
+		return []string{AllScopes}
+	}
+	return strings.Fields(scope)
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (id *Identity) String() string {
+	return fmt.Sprintf("%s:%s", id.Source, id.Name)
+}
@@ -0,0 +1,99 @@
+package util
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func certContext(ctx context.Context, cert *x509.Certificate) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{
+		State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+	}})
+}
+
+func TestCertificateIdentity(t *testing.T) {
+	var conf model.Config
+	conf.SigninKey = "signkey"
+	conf.AuthKey = "authkey"
+	conf.ClientCerts.Identities = append(conf.ClientCerts.Identities,
+		model.CertIdentity{CN: "daemon", SAN: []string{"daemon.haaukins.dk"}, Name: "haaukins-daemon", Scopes: []string{ReadScope, WriteScope}},
+		model.CertIdentity{CN: "dashboard", Scopes: []string{ReadScope}},
+	)
+
+	daemonCert := &x509.Certificate{Subject: pkix.Name{CommonName: "daemon"}, DNSNames: []string{"daemon.haaukins.dk"}}
+	daemonNoSAN := &x509.Certificate{Subject: pkix.Name{CommonName: "daemon"}}
+	dashboardCert := &x509.Certificate{Subject: pkix.Name{CommonName: "dashboard"}}
+
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{AUTH_KEY: "authkey", "sub": "cli"}).SignedString([]byte("signkey"))
+	withToken := metadata.NewIncomingContext(context.Background(), metadata.Pairs("token", token))
+
+	tt := []struct {
+		name     string
+		mode     string
+		ctx      context.Context
+		identity string
+		method   string
+		denied   bool
+		err      bool
+	}{
+		{name: "Cert", mode: CertAuthMode, ctx: certContext(context.Background(), daemonCert), identity: "haaukins-daemon", method: "/store.Store/AddTeam"},
+		{name: "Cert missing SAN", mode: CertAuthMode, ctx: certContext(context.Background(), daemonNoSAN), err: true},
+		{name: "Cert without certificate", mode: CertAuthMode, ctx: withToken, err: true},
+		{name: "Cert read only scope", mode: CertAuthMode, ctx: certContext(context.Background(), dashboardCert), identity: "dashboard", method: "/store.Store/DropEvent", denied: true},
+		{name: "Cert and JWT", mode: CertAndJWTAuthMode, ctx: certContext(withToken, daemonCert), identity: "haaukins-daemon", method: "/store.Store/GetEvents"},
+		{name: "Cert and JWT without token", mode: CertAndJWTAuthMode, ctx: certContext(context.Background(), daemonCert), err: true},
+		{name: "Cert or JWT with token", mode: CertOrJWTAuthMode, ctx: withToken, identity: "cli", method: "/store.Store/DropEvent"},
+		{name: "Cert or JWT with certificate", mode: CertOrJWTAuthMode, ctx: certContext(context.Background(), dashboardCert), identity: "dashboard", method: "/store.Store/GetEvents"},
+		{name: "JWT", mode: JWTAuthMode, ctx: certContext(withToken, dashboardCert), identity: "cli", method: "/store.Store/DropEvent"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			conf.ClientCerts.Mode = tc.mode
+			a, err := newAuthenticator(&conf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			id, err := a.AuthenticateContext(tc.ctx)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected error, but received none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, but received: %s", err)
+			}
+			if id.Name != tc.identity {
+				t.Fatalf("unexpected identity (expected: %s) received: %s", tc.identity, id.Name)
+			}
+			if id.CanCall(tc.method) == tc.denied {
+				t.Fatalf("unexpected permission for %s on %s", id, tc.method)
+			}
+		})
+	}
+}
+
+// every RPC of the store should have its scope, unmapped ones fall back to admin scope
+func TestMethodScopes(t *testing.T) {
+	methods := pb.File_store_proto.Services().ByName("Store").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		name := "/store.Store/" + string(methods.Get(i).Name())
+		if _, ok := methodScopes[name]; !ok {
+			t.Errorf("no scope is defined for %s", name)
+		}
+	}
+	if scope := methodScope("/store.Store/Unknown"); scope != AdminScope {
+		t.Errorf("expected unknown methods to require admin scope, got %s", scope)
+	}
+}
@@ -0,0 +1,181 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+const (
+	defaultInactivityInterval = 5 * time.Minute
+	webhookTimeout            = 10 * time.Second
+	// inactivityLock is held by the replica which flags inactive teams
+	inactivityLock = "haaukins-store-inactive-teams"
+)
+
+// inactivityMonitor flags teams of running events which have not accessed
+// their event for inactive-for, so the daemon could reclaim their lab resources.
+// Newly flagged teams are posted to the webhook when it is configured.
+// When several replicas share the database, only the one holding the lock acts.
+type inactivityMonitor struct {
+	store       database.Store
+	interval    time.Duration
+	inactiveFor time.Duration
+	webhook     string
+	client      *http.Client
+	leader      bool
+	now         func() time.Time
+}
+
+func newInactivityMonitor(store database.Store, conf *model.Config) *inactivityMonitor {
+	m := &inactivityMonitor{
+		store:       store,
+		interval:    conf.InactiveTeams.Interval,
+		inactiveFor: conf.InactiveTeams.InactiveFor,
+		webhook:     conf.InactiveTeams.Webhook,
+		client:      &http.Client{Timeout: webhookTimeout},
+		now:         func() time.Time { return time.Now().UTC() },
+	}
+	if m.interval <= 0 {
+		m.interval = defaultInactivityInterval
+	}
+	return m
+}
+
+// tick flags inactive teams when this replica is the leader
+func (m *inactivityMonitor) tick(ctx context.Context) {
+	leader, err := m.store.Leader(ctx, inactivityLock)
+	if err != nil {
+		log.Printf("ERR: Error electing inactive teams leader: %s", err.Error())
+	}
+	if leader != m.leader {
+		m.leader = leader
+		if leader {
+			log.Printf("Inactive teams are flagged by this server")
+		} else {
+			log.Printf("Inactive teams are flagged by another server")
+		}
+	}
+	if !leader {
+		return
+	}
+
+	now := m.now()
+	flagged, err := m.store.FlagInactiveTeams(now, now.Add(-m.inactiveFor))
+	if err != nil {
+		log.Printf("ERR: Error flagging inactive teams: %s", err.Error())
+		return
+	}
+	for _, t := range flagged {
+		log.Printf("Team %s of event %s is inactive since %s", t.Id, t.EventTag, t.LastAccess.Format(database.TimeFormat))
+	}
+	if len(flagged) == 0 || m.webhook == "" {
+		return
+	}
+	if err := m.notify(ctx, flagged); err != nil {
+		log.Printf("ERR: Error notifying about %d inactive teams: %s", len(flagged), err.Error())
+	}
+}
+
+// notify posts newly flagged teams to the webhook as {"teams": [...]}
+func (m *inactivityMonitor) notify(ctx context.Context, teams []model.InactiveTeam) error {
+	body, err := json.Marshal(struct {
+		Teams []model.InactiveTeam `json:"teams"`
+	}{teams})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
+
+// run flags inactive teams periodically until context is cancelled
+func (m *inactivityMonitor) run(ctx context.Context) {
+	m.tick(ctx)
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.tick(ctx)
+		}
+	}
+}
+
+// RunInactivityMonitor flags teams which are inactive for longer than
+// configured period until context is cancelled
+func (s server) RunInactivityMonitor(ctx context.Context) {
+	s.inactivity.run(ctx)
+}
+
+// listInactiveTeams lists teams of the most recent event with given tag which have not accessed
+// the event for the requested period, or for inactive-for of the policy by default
+func listInactiveTeams(store database.Store, m *inactivityMonitor, in *pb.ListInactiveTeamsRequest) (*pb.ListInactiveTeamsResponse, error) {
+	var inactiveFor time.Duration
+	switch {
+	case in.InactiveFor != "":
+		var err error
+		if inactiveFor, err = time.ParseDuration(in.InactiveFor); err != nil || inactiveFor <= 0 {
+			return nil, fmt.Errorf("invalid inactive for %q", in.InactiveFor)
+		}
+	case m != nil:
+		inactiveFor = m.inactiveFor
+	default:
+		return nil, errors.New("inactive for is required when inactive teams policy is disabled")
+	}
+
+	now := time.Now().UTC()
+	if m != nil {
+		now = m.now()
+	}
+	teams, err := store.GetInactiveTeams(in.EventTag, now.Add(-inactiveFor))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListInactiveTeamsResponse{}
+	for _, t := range teams {
+		team := &pb.ListInactiveTeamsResponse_Team{
+			Id:              t.Id,
+			Name:            t.Name,
+			LastAccess:      t.LastAccess.Format(database.TimeFormat),
+			InactiveSeconds: int64(now.Sub(t.LastAccess).Seconds()),
+		}
+		if !t.FlaggedAt.IsZero() {
+			team.FlaggedAt = t.FlaggedAt.Format(database.TimeFormat)
+		}
+		resp.Teams = append(resp.Teams, team)
+	}
+	return resp, nil
+}
+
+func (s server) ListInactiveTeams(ctx context.Context, in *pb.ListInactiveTeamsRequest) (*pb.ListInactiveTeamsResponse, error) {
+	resp, err := listInactiveTeams(s.store, s.inactivity, in)
+	if err != nil {
+		log.Printf("ERR: Error List Inactive Teams %s : %s", in.EventTag, err.Error())
+		return &pb.ListInactiveTeamsResponse{ErrorMessage: err.Error()}, nil
+	}
+	return resp, nil
+}
@@ -0,0 +1,125 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+type inactiveStore struct {
+	database.Store
+	leader  bool
+	teams   []model.InactiveTeam
+	flagged []time.Time
+	listed  []time.Time
+}
+
+func (s *inactiveStore) Leader(ctx context.Context, name string) (bool, error) {
+	return s.leader, nil
+}
+
+func (s *inactiveStore) FlagInactiveTeams(now, before time.Time) ([]model.InactiveTeam, error) {
+	s.flagged = append(s.flagged, before)
+	return s.teams, nil
+}
+
+func (s *inactiveStore) GetInactiveTeams(tag string, before time.Time) ([]model.InactiveTeam, error) {
+	s.listed = append(s.listed, before)
+	return s.teams, nil
+}
+
+func TestInactivityMonitor(t *testing.T) {
+	now := time.Date(2021, 6, 4, 12, 0, 0, 0, time.UTC)
+	store := &inactiveStore{teams: []model.InactiveTeam{
+		{EventTag: "test", Id: "t1", Name: "Team 1", LastAccess: now.Add(-3 * time.Hour), FlaggedAt: now},
+	}}
+
+	var received []model.InactiveTeam
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Teams []model.InactiveTeam `json:"teams"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received = append(received, body.Teams...)
+	}))
+	defer hook.Close()
+
+	var conf model.Config
+	conf.InactiveTeams.Enabled = true
+	conf.InactiveTeams.InactiveFor = 2 * time.Hour
+	conf.InactiveTeams.Webhook = hook.URL
+	m := newInactivityMonitor(store, &conf)
+	m.now = func() time.Time { return now }
+
+	// replicas which do not hold the lock do nothing
+	m.tick(context.Background())
+	if len(store.flagged) != 0 || len(received) != 0 {
+		t.Fatalf("expected no flags without leadership")
+	}
+
+	store.leader = true
+	m.tick(context.Background())
+	if len(store.flagged) != 1 || !store.flagged[0].Equal(now.Add(-2*time.Hour)) {
+		t.Fatalf("expected teams inactive since %s to be flagged, but received %v", now.Add(-2*time.Hour), store.flagged)
+	}
+	if len(received) != 1 || received[0].Id != "t1" || received[0].EventTag != "test" {
+		t.Fatalf("expected webhook to receive flagged team, but received %+v", received)
+	}
+	if m.interval != defaultInactivityInterval {
+		t.Errorf("expected default interval %s, but received %s", defaultInactivityInterval, m.interval)
+	}
+}
+
+func TestListInactiveTeams(t *testing.T) {
+	now := time.Now().UTC()
+	store := &inactiveStore{teams: []model.InactiveTeam{
+		{EventTag: "test", Id: "t1", Name: "Team 1", LastAccess: now.Add(-3 * time.Hour), FlaggedAt: now.Add(-time.Hour)},
+		{EventTag: "test", Id: "t2", Name: "Team 2", LastAccess: now.Add(-90 * time.Minute)},
+	}}
+	s := server{store: store}
+
+	resp, err := s.ListInactiveTeams(context.Background(), &pb.ListInactiveTeamsRequest{EventTag: "test", InactiveFor: "1h"})
+	if err != nil || resp.ErrorMessage != "" {
+		t.Fatalf("ListInactiveTeams() error = %v, %s", err, resp.ErrorMessage)
+	}
+	if len(resp.Teams) != 2 {
+		t.Fatalf("expected 2 teams, got %+v", resp.Teams)
+	}
+	if team := resp.Teams[0]; team.Id != "t1" || team.InactiveSeconds < 3*60*60 || team.FlaggedAt == "" {
+		t.Errorf("unexpected flagged team %+v", team)
+	}
+	if team := resp.Teams[1]; team.Id != "t2" || team.FlaggedAt != "" {
+		t.Errorf("unexpected team %+v", team)
+	}
+	if d := store.listed[0].Sub(now.Add(-time.Hour)); d < 0 || d > time.Minute {
+		t.Errorf("expected teams inactive since %s, but listed since %s", now.Add(-time.Hour), store.listed[0])
+	}
+
+	// without a policy, inactive period should be given
+	for _, in := range []*pb.ListInactiveTeamsRequest{
+		{EventTag: "test"},
+		{EventTag: "test", InactiveFor: "-1h"},
+		{EventTag: "test", InactiveFor: "a while"},
+	} {
+		if resp, _ := s.ListInactiveTeams(context.Background(), in); resp.ErrorMessage == "" {
+			t.Errorf("expected error on request %+v", in)
+		}
+	}
+
+	var conf model.Config
+	conf.InactiveTeams.InactiveFor = 2 * time.Hour
+	s.inactivity = newInactivityMonitor(store, &conf)
+	if resp, _ := s.ListInactiveTeams(context.Background(), &pb.ListInactiveTeamsRequest{EventTag: "test"}); resp.ErrorMessage != "" {
+		t.Errorf("expected inactive for of the policy to be used, got error %s", resp.ErrorMessage)
+	}
+}
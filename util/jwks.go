@@ -0,0 +1,216 @@
+package util
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+var (
+	NoMatchingKeyErr = errors.New("No matching key found in key set")
+)
+
+// jwk is a single JSON Web Key as described in RFC 7517,
+// only public key parameters are read.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type publicKey struct {
+	kid string
+	alg string
+	key crypto.PublicKey
+}
+
+// keySet keeps public keys loaded from a JWKS file on disk,
+// the file is loaded again whenever its modification time or size changes.
+type keySet struct {
+	m       sync.Mutex
+	path    string
+	modTime time.Time
+	size    int64
+	keys    []publicKey
+}
+
+func newKeySet(path string) (*keySet, error) {
+	ks := &keySet{path: path}
+	if err := ks.refresh(); err != nil {
+		return nil, err
+	}
+	return ks, nil
+}
+
+// refresh reloads the key set when the file changed on disk,
+// in case of an error previously loaded keys are kept.
+func (ks *keySet) refresh() error {
+	fi, err := os.Stat(ks.path)
+	if err != nil {
+		return err
+	}
+	if fi.ModTime().Equal(ks.modTime) && fi.Size() == ks.size && ks.keys != nil {
+		return nil
+	}
+
+	f, err := ioutil.ReadFile(ks.path)
+	if err != nil {
+		return err
+	}
+	keys, err := parseKeySet(f)
+	if err != nil {
+		return err
+	}
+
+	ks.keys = keys
+	ks.modTime = fi.ModTime()
+	ks.size = fi.Size()
+	log.Printf("Loaded %d public keys from key set %s", len(keys), ks.path)
+	return nil
+}
+
+// lookup returns the public key which should be used to verify given token
+func (ks *keySet) lookup(token *jwt.Token) (crypto.PublicKey, error) {
+	ks.m.Lock()
+	defer ks.m.Unlock()
+
+	if err := ks.refresh(); err != nil {
+		log.Printf("ERR: Error reloading key set %s: %s", ks.path, err.Error())
+	}
+
+	alg := token.Method.Alg()
+	kid, _ := token.Header["kid"].(string)
+	for _, k := range ks.keys {
+		if kid != "" && k.kid != kid {
+			continue
+		}
+		if k.alg != "" && k.alg != alg {
+			continue
+		}
+		if !keyMatchesMethod(k.key, token.Method) {
+			continue
+		}
+		return k.key, nil
+	}
+	return nil, NoMatchingKeyErr
+}
+
+func keyMatchesMethod(key crypto.PublicKey, method jwt.SigningMethod) bool {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		_, ok := method.(*jwt.SigningMethodRSA)
+		return ok
+	case *ecdsa.PublicKey:
+		_, ok := method.(*jwt.SigningMethodECDSA)
+		return ok
+	case ed25519.PublicKey:
+		_, ok := method.(*jwt.SigningMethodEd25519)
+		return ok
+	}
+	return false
+}
+
+func parseKeySet(data []byte) ([]publicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid key set: %v", err)
+	}
+
+	var keys []publicKey
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("key %d (kid: %q): %v", i, k.Kid, err)
+		}
+		keys = append(keys, publicKey{kid: k.Kid, alg: k.Alg, key: key})
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("key set does not contain any signing key")
+	}
+	return keys, nil
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBase64URL(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBase64URL(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBase64URL(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBase64URL(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("point is not on curve")
+		}
+		return key, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBase64URL(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+func decodeBase64URL(s string) ([]byte, error) {
+	if s == "" {
+		return nil, errors.New("missing key parameter")
+	}
+	return base64.RawURLEncoding.DecodeString(s)
+}
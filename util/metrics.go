@@ -0,0 +1,90 @@
+package util
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+)
+
+// MetricsPath is where prometheus metrics are served
+const MetricsPath = "/metrics"
+
+// metrics keeps RPC metrics and the registry which is served on metrics endpoint
+type metrics struct {
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func newMetrics(store database.Store) *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "haaukins_store",
+			Name:      "grpc_requests_total",
+			Help:      "Number of RPCs by method and response code.",
+		}, []string{"method", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "haaukins_store",
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Latency of RPCs by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+	}
+	m.registry.MustRegister(
+		m.requests,
+		m.duration,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	m.registry.MustRegister(store.Collectors()...)
+	return m
+}
+
+// observe counts the call by its code, failures reported in response are counted as Unknown
+func (m *metrics) observe(method string, start time.Time, resp interface{}, err error) {
+	method = path.Base(method)
+	code, _ := callResult(resp, err)
+	m.requests.WithLabelValues(method, code.String()).Inc()
+	m.duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func (m *metrics) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	m.observe(info.FullMethod, start, resp, err)
+	return resp, err
+}
+
+func (m *metrics) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, stream)
+	m.observe(info.FullMethod, start, nil, err)
+	return err
+}
+
+// ServeMetrics serves prometheus metrics on configured address until context is cancelled
+func (s server) ServeMetrics(ctx context.Context, conf *model.Config) error {
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, promhttp.HandlerFor(s.metrics.registry, promhttp.HandlerOpts{}))
+	srv := &http.Server{Addr: conf.Metrics.Host, Handler: mux}
+	go func() {
+		<-ctx.Done()
+		srv.Close()
+	}()
+
+	log.Printf("Metrics are served on %s%s", conf.Metrics.Host, MetricsPath)
+	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
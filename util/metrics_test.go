@@ -0,0 +1,53 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type metricsStore struct {
+	database.Store
+}
+
+func (s *metricsStore) Collectors() []prometheus.Collector {
+	return nil
+}
+
+func TestMetricsInterceptor(t *testing.T) {
+	m := newMetrics(&metricsStore{})
+	info := &grpc.UnaryServerInfo{FullMethod: "/store.Store/GetEvents"}
+
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	failed := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.Unauthenticated, "no token")
+	}
+	m.unaryInterceptor(context.Background(), nil, info, ok)
+	m.unaryInterceptor(context.Background(), nil, info, ok)
+	m.unaryInterceptor(context.Background(), nil, info, failed)
+	// failures reported in response are not counted as successful
+	inBand := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.GetEventResponse{ErrorMessage: "no events"}, nil
+	}
+	m.unaryInterceptor(context.Background(), nil, info, inBand)
+
+	if got := testutil.ToFloat64(m.requests.WithLabelValues("GetEvents", "OK")); got != 2 {
+		t.Fatalf("unexpected number of successful requests (expected: 2) received: %v", got)
+	}
+	if got := testutil.ToFloat64(m.requests.WithLabelValues("GetEvents", "Unauthenticated")); got != 1 {
+		t.Fatalf("unexpected number of failed requests (expected: 1) received: %v", got)
+	}
+	if got := testutil.ToFloat64(m.requests.WithLabelValues("GetEvents", "Unknown")); got != 1 {
+		t.Fatalf("unexpected number of requests failed in response (expected: 1) received: %v", got)
+	}
+	if got := testutil.CollectAndCount(m.duration); got != 1 {
+		t.Fatalf("unexpected number of latency histograms (expected: 1) received: %v", got)
+	}
+}
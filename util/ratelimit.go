@@ -0,0 +1,295 @@
+package util
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RetryAfterKey is the response header which tells the client
+// how many seconds it should wait before trying again
+const RetryAfterKey = "retry-after"
+
+// bucketIdleTimeout is the least time a bucket is kept after its last call,
+// buckets are removed only when their tokens are refilled and no call is in flight
+const bucketIdleTimeout = 10 * time.Minute
+
+// limit is token bucket rate and in-flight cap, zero values are unlimited
+type limit struct {
+	rate        float64
+	burst       int
+	maxInFlight int
+}
+
+// bucket is the state of a single client, or a single client and RPC pair
+type bucket struct {
+	method   string        // empty for the bucket of the client
+	limiter  *rate.Limiter // nil when rate is unlimited
+	inFlight int
+	lastUsed time.Time
+}
+
+// rateLimiter enforces limits per authenticated client and per RPC,
+// every client has its own buckets, they are not shared between clients
+type rateLimiter struct {
+	m       sync.Mutex
+	client  limit            // applies to all calls of a client
+	methods map[string]limit // applies to calls of a client to given RPC
+	buckets map[string]*bucket
+	swept   time.Time // last time idle buckets were removed
+}
+
+func newRateLimiter(conf *model.Config) *rateLimiter {
+	l := &rateLimiter{buckets: map[string]*bucket{}}
+	l.client, l.methods = configLimits(conf)
+	return l
+}
+
+func configLimits(conf *model.Config) (limit, map[string]limit) {
+	client := limit{rate: conf.RateLimit.Rate, burst: conf.RateLimit.Burst, maxInFlight: conf.RateLimit.MaxInFlight}
+	methods := map[string]limit{}
+	for m, c := range conf.RateLimit.Methods {
+		methods[m] = limit{rate: c.Rate, burst: c.Burst, maxInFlight: c.MaxInFlight}
+	}
+	return client, methods
+}
+
+// update replaces limits by the ones in configuration, existing buckets
+// are kept, so calls in flight and consumed tokens count against new limits
+func (l *rateLimiter) update(conf *model.Config) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	l.client, l.methods = configLimits(conf)
+	for key, b := range l.buckets {
+		lim := l.client
+		if b.method != "" {
+			m, ok := l.methods[b.method]
+			if !ok {
+				delete(l.buckets, key)
+				continue
+			}
+			lim = m
+		}
+		b.setLimit(lim)
+	}
+}
+
+func (l *rateLimiter) bucket(key, method string, lim limit) *bucket {
+	b, ok := l.buckets[key]
+	if ok {
+		return b
+	}
+	b = &bucket{method: method}
+	b.setLimit(lim)
+	l.buckets[key] = b
+	return b
+}
+
+func (b *bucket) setLimit(lim limit) {
+	if lim.rate <= 0 {
+		b.limiter = nil
+		return
+	}
+	burst := lim.burst
+	if burst <= 0 {
+		burst = int(math.Ceil(lim.rate))
+	}
+	if b.limiter == nil {
+		b.limiter = rate.NewLimiter(rate.Limit(lim.rate), burst)
+		return
+	}
+	now := time.Now()
+	b.limiter.SetLimitAt(now, rate.Limit(lim.rate))
+	b.limiter.SetBurstAt(now, burst)
+}
+
+// acquire takes a token and an in-flight slot for the call of client to method,
+// returned function releases the in-flight slot when the call is done.
+// when call is not allowed, it returns the duration client should wait.
+func (l *rateLimiter) acquire(client, method string) (func(), time.Duration, error) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	type check struct {
+		b   *bucket
+		lim limit
+	}
+	now := time.Now()
+	if now.Sub(l.swept) >= bucketIdleTimeout {
+		l.sweep(now)
+	}
+
+	checks := []check{{b: l.bucket(client, "", l.client), lim: l.client}}
+	if lim, ok := l.methods[path.Base(method)]; ok {
+		checks = append(checks, check{b: l.bucket(client+method, path.Base(method), lim), lim: lim})
+	}
+
+	for _, c := range checks {
+		if c.lim.maxInFlight > 0 && c.b.inFlight >= c.lim.maxInFlight {
+			return nil, time.Second, fmt.Errorf("too many concurrent requests, at most %d allowed", c.lim.maxInFlight)
+		}
+	}
+
+	var reservations []*rate.Reservation
+	for _, c := range checks {
+		if c.b.limiter == nil {
+			continue
+		}
+		r := c.b.limiter.ReserveN(now, 1)
+		reservations = append(reservations, r)
+		if delay := r.DelayFrom(now); !r.OK() || delay > 0 {
+			for _, r := range reservations {
+				r.CancelAt(now)
+			}
+			if !r.OK() {
+				delay = time.Second
+			}
+			return nil, delay, fmt.Errorf("rate limit exceeded, at most %g requests per second allowed", c.lim.rate)
+		}
+	}
+
+	for _, c := range checks {
+		c.b.inFlight++
+		c.b.lastUsed = now
+	}
+	return func() {
+		l.m.Lock()
+		defer l.m.Unlock()
+		for _, c := range checks {
+			c.b.inFlight--
+		}
+	}, 0, nil
+}
+
+// sweep removes buckets which are idle, so buckets of clients which went away do not pile up.
+// A bucket is idle when no call is in flight and it is not used for long enough to refill,
+// so removing it does not give its client more tokens.
+func (l *rateLimiter) sweep(now time.Time) {
+	l.swept = now
+	for key, b := range l.buckets {
+		if b.inFlight > 0 {
+			continue
+		}
+		idle := bucketIdleTimeout
+		if b.limiter != nil {
+			if refill := time.Duration(float64(b.limiter.Burst()) / float64(b.limiter.Limit()) * float64(time.Second)); refill > idle {
+				idle = refill
+			}
+		}
+		if now.Sub(b.lastUsed) >= idle {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// enabled reports whether any limit is configured
+func (l *rateLimiter) enabled() bool {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if l.client != (limit{}) {
+		return true
+	}
+	for _, m := range l.methods {
+		if m != (limit{}) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *rateLimiter) allow(ctx context.Context, method string) (func(), error) {
+	if isHealthMethod(method) || !l.enabled() {
+		return func() {}, nil
+	}
+	release, retryAfter, err := l.acquire(rateLimitKey(ctx), method)
+	if err != nil {
+		seconds := int(math.Ceil(retryAfter.Seconds()))
+		grpc.SetHeader(ctx, metadata.Pairs(RetryAfterKey, strconv.Itoa(seconds)))
+		return nil, status.Errorf(codes.ResourceExhausted, "%s, retry after %d seconds", err, seconds)
+	}
+	return release, nil
+}
+
+// rateLimitKey identifies the client whose buckets the call counts against.
+// Identities without a name, such as every legacy HMAC token, are told apart
+// by their peer, so the daemon and admin tools do not share their limits.
+func rateLimitKey(ctx context.Context) string {
+	client := "unknown"
+	id, ok := IdentityFromContext(ctx)
+	if ok {
+		client = id.String()
+	}
+	if ok && id.Name != AnonymousName {
+		return client
+	}
+	return client + "@" + peerKey(ctx)
+}
+
+// peerKey is the fingerprint of the verified client certificate, or address of the peer.
+// Calls through the gateway arrive on its internal connection, they are keyed by the
+// address which the gateway appended to x-forwarded-for, earlier hops are sent by
+// the client and are not trusted. Forwarding metadata of other connections is ignored.
+func peerKey(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
+		sum := sha256.Sum256(tlsInfo.State.VerifiedChains[0][0].Raw)
+		return "cert:" + hex.EncodeToString(sum[:])
+	}
+	if p.Addr.Network() == gatewayNetwork {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if fwd := md.Get("x-forwarded-for"); len(fwd) > 0 {
+				hops := strings.Split(fwd[len(fwd)-1], ",")
+				return "gateway:" + strings.TrimSpace(hops[len(hops)-1])
+			}
+		}
+	}
+	return "addr:" + hostOf(p.Addr.String())
+}
+
+// hostOf drops port of the address, every connection of a host shares its buckets
+func hostOf(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+func (l *rateLimiter) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	release, err := l.allow(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return handler(ctx, req)
+}
+
+func (l *rateLimiter) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	release, err := l.allow(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return handler(srv, stream)
+}
@@ -0,0 +1,128 @@
+package util
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestRateLimiter(t *testing.T) {
+	var conf model.Config
+	conf.RateLimit.Rate = 1
+	conf.RateLimit.Burst = 3
+	conf.RateLimit.Methods = map[string]model.RateLimit{
+		"UpdateTeamLastAccess": {Rate: 1, Burst: 1},
+		"GetEvents":            {MaxInFlight: 1},
+	}
+	l := newRateLimiter(&conf)
+
+	// method limit is exhausted after a single call
+	if _, _, err := l.acquire("daemon", "/store.Store/UpdateTeamLastAccess"); err != nil {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+	if _, retryAfter, err := l.acquire("daemon", "/store.Store/UpdateTeamLastAccess"); err == nil || retryAfter <= 0 {
+		t.Fatalf("expected rate limit error with retry after, but received: %v (%s)", err, retryAfter)
+	}
+
+	// rejected call should not consume tokens of the client bucket
+	release, _, err := l.acquire("daemon", "/store.Store/GetEvents")
+	if err != nil {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+	if _, _, err := l.acquire("daemon", "/store.Store/GetEvents"); err == nil {
+		t.Fatalf("expected in-flight limit error, but received none")
+	}
+	release()
+	if release, _, err = l.acquire("daemon", "/store.Store/GetEvents"); err != nil {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+	release()
+
+	// client bucket is exhausted, other clients are not affected
+	if _, _, err := l.acquire("daemon", "/store.Store/GetEvents"); err == nil {
+		t.Fatalf("expected client rate limit error, but received none")
+	}
+	if _, _, err := l.acquire("cli", "/store.Store/GetEvents"); err != nil {
+		t.Fatalf("expected no error for another client, but received: %s", err)
+	}
+}
+
+type gatewayAddr struct{}
+
+func (gatewayAddr) Network() string { return gatewayNetwork }
+func (gatewayAddr) String() string  { return gatewayNetwork }
+
+func TestRateLimitKey(t *testing.T) {
+	peerContext := func(id *Identity, addr net.Addr, forwarded ...string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		if len(forwarded) > 0 {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", strings.Join(forwarded, ", ")))
+		}
+		return NewIdentityContext(ctx, id)
+	}
+	host := func(ip byte, port int) net.Addr {
+		return &net.TCPAddr{IP: net.IPv4(10, 0, 0, ip), Port: port}
+	}
+	anonymous := &Identity{Name: AnonymousName, Source: JWTAuthMode}
+	daemon := &Identity{Name: "daemon", Source: JWTAuthMode}
+
+	// legacy tokens of different hosts have their own buckets
+	if rateLimitKey(peerContext(anonymous, host(1, 1000))) == rateLimitKey(peerContext(anonymous, host(2, 1000))) {
+		t.Errorf("expected anonymous identities of different hosts to have different keys")
+	}
+	// new connections of the same host do not get new buckets
+	if rateLimitKey(peerContext(anonymous, host(1, 1000))) != rateLimitKey(peerContext(anonymous, host(1, 2000))) {
+		t.Errorf("expected anonymous identities of the same host to have the same key")
+	}
+	// forwarding metadata of direct connections is not trusted
+	if rateLimitKey(peerContext(anonymous, host(1, 1000), "192.0.2.1")) != rateLimitKey(peerContext(anonymous, host(1, 1000), "192.0.2.2")) {
+		t.Errorf("expected x-forwarded-for of direct connections to be ignored")
+	}
+	// calls through the gateway are keyed by the hop the gateway appended
+	spoofed := rateLimitKey(peerContext(anonymous, gatewayAddr{}, "192.0.2.1", "198.51.100.7"))
+	if spoofed != rateLimitKey(peerContext(anonymous, gatewayAddr{}, "192.0.2.2", "198.51.100.7")) {
+		t.Errorf("expected hops sent by the client to be ignored, got %s", spoofed)
+	}
+	if spoofed == rateLimitKey(peerContext(anonymous, gatewayAddr{}, "198.51.100.8")) {
+		t.Errorf("expected gateway clients of different hosts to have different keys")
+	}
+	// named identities share their buckets on every connection
+	if key := rateLimitKey(peerContext(daemon, host(1, 1000))); key != rateLimitKey(peerContext(daemon, host(2, 2000))) || key != daemon.String() {
+		t.Errorf("expected named identity to be keyed by its name, got %s", key)
+	}
+}
+
+func TestRateLimiterSweep(t *testing.T) {
+	var conf model.Config
+	conf.RateLimit.Rate = 1
+	conf.RateLimit.Burst = 1
+	l := newRateLimiter(&conf)
+
+	release, _, err := l.acquire("busy", "/store.Store/GetEvents")
+	if err != nil {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+	done, _, err := l.acquire("idle", "/store.Store/GetEvents")
+	if err != nil {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+	done()
+	l.buckets["idle"].lastUsed = time.Now().Add(-2 * bucketIdleTimeout)
+	l.buckets["busy"].lastUsed = time.Now().Add(-2 * bucketIdleTimeout)
+
+	// buckets with calls in flight are kept
+	l.sweep(time.Now())
+	if _, ok := l.buckets["idle"]; ok {
+		t.Errorf("expected idle bucket to be removed")
+	}
+	if _, ok := l.buckets["busy"]; !ok {
+		t.Errorf("expected bucket with a call in flight to be kept")
+	}
+	release()
+}
@@ -0,0 +1,197 @@
+package util
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/model"
+)
+
+// certReloader keeps server certificate and client certificate authorities
+// loaded from files on disk, files are loaded again when any of them changes,
+// e.g. when Let's Encrypt renews the certificate, or when reload is forced.
+// Connections which are already established keep using the old certificate.
+type certReloader struct {
+	m         sync.Mutex
+	files     certificate
+	modTimes  map[string]time.Time
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+}
+
+func newCertReloader(conf *model.Config) (*certReloader, error) {
+	r := &certReloader{}
+	if err := r.reload(conf); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// reload loads certificate files given in configuration,
+// in case of an error previously loaded certificates are kept.
+func (r *certReloader) reload(conf *model.Config) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	return r.load(certificate{
+		cPath:    conf.TLS.CertFile,
+		cKeyPath: conf.TLS.CertKey,
+		caPath:   conf.TLS.CAFile,
+	})
+}
+
+func (r *certReloader) load(files certificate) error {
+	modTimes, err := fileModTimes(files)
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(files.cPath, files.cKeyPath)
+	if err != nil {
+		return fmt.Errorf("could not load server key pair: %s", err)
+	}
+
+	// Create a certificate pool from the certificate authority
+	certPool := x509.NewCertPool()
+	ca, err := ioutil.ReadFile(files.caPath)
+	if err != nil {
+		return fmt.Errorf("could not read ca certificate: %s", err)
+	}
+	// CA file for let's encrypt is located under domain conf as `chain.pem`
+	// pass chain.pem location
+	// Append the client certificates from the CA
+	if ok := certPool.AppendCertsFromPEM(ca); !ok {
+		return errors.New("failed to append client certs")
+	}
+
+	r.files = files
+	r.modTimes = modTimes
+	r.cert = &cert
+	r.clientCAs = certPool
+	log.Printf("Loaded server certificate %s and certificate authority %s", files.cPath, files.caPath)
+	return nil
+}
+
+// refresh loads files again when modification time of any of them changed
+func (r *certReloader) refresh() {
+	modTimes, err := fileModTimes(r.files)
+	if err != nil {
+		log.Printf("ERR: Error checking certificate files: %s", err.Error())
+		return
+	}
+	for f, t := range modTimes {
+		if !t.Equal(r.modTimes[f]) {
+			if err := r.load(r.files); err != nil {
+				log.Printf("ERR: Error reloading certificates, previous ones are kept: %s", err.Error())
+			}
+			return
+		}
+	}
+}
+
+func (r *certReloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.refresh()
+	return r.cert, r.clientCAs
+}
+
+func (r *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert, _ := r.current()
+	return cert, nil
+}
+
+// getConfigForClient returns configuration of a new connection
+// with the latest certificate and certificate authorities
+func (r *certReloader) getConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	cert, clientCAs := r.current()
+	return &tls.Config{
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		Certificates: []tls.Certificate{*cert},
+		ClientCAs:    clientCAs,
+		// returned configuration replaces the one of the listener, so
+		// protocols of both gRPC server and gateway are advertised
+		NextProtos: []string{"h2", "http/1.1"},
+	}, nil
+}
+
+// tlsConfig returns server TLS configuration which requires and verifies
+// client certificates signed by the current certificate authority
+func (r *certReloader) tlsConfig() *tls.Config {
+	_, clientCAs := r.current()
+	return &tls.Config{
+		ClientAuth:         tls.RequireAndVerifyClientCert,
+		ClientCAs:          clientCAs,
+		GetCertificate:     r.getCertificate,
+		GetConfigForClient: r.getConfigForClient,
+	}
+}
+
+func fileModTimes(files certificate) (map[string]time.Time, error) {
+	modTimes := map[string]time.Time{}
+	for _, f := range []string{files.cPath, files.cKeyPath, files.caPath} {
+		fi, err := os.Stat(f)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[f] = fi.ModTime()
+	}
+	return modTimes, nil
+}
+
+// reloadableAuth is the authenticator which could be replaced
+// while calls are being authenticated
+type reloadableAuth struct {
+	m    sync.RWMutex
+	auth Authenticator
+}
+
+func (a *reloadableAuth) AuthenticateContext(ctx context.Context) (*Identity, error) {
+	a.m.RLock()
+	auth := a.auth
+	a.m.RUnlock()
+	return auth.AuthenticateContext(ctx)
+}
+
+func (a *reloadableAuth) set(auth Authenticator) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.auth = auth
+}
+
+// Reload applies certificates, authentication keys, client certificate identities
+// and rate limits of the given configuration without restarting the server.
+// Other changes, e.g. addresses or database parameters, require restart.
+func (s server) Reload(conf *model.Config) error {
+	auth, err := newAuthenticator(conf)
+	if err != nil {
+		return err
+	}
+
+	if s.certs != nil {
+		if !conf.TLS.Enabled {
+			return errors.New("TLS could not be disabled without restart")
+		}
+		if err := s.certs.reload(conf); err != nil {
+			return err
+		}
+	} else if conf.TLS.Enabled {
+		return errors.New("TLS could not be enabled without restart")
+	}
+
+	if r, ok := s.auth.(*reloadableAuth); ok {
+		r.set(auth)
+	}
+
+	s.limit.update(conf)
+	log.Printf("Configuration is reloaded")
+	return nil
+}
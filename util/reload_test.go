@@ -0,0 +1,162 @@
+package util
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// writeCertificate writes a self signed certificate with given common name
+// as server certificate, key and certificate authority into dir
+func writeCertificate(t *testing.T, dir, cn string, modTime time.Time) *model.Config {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var conf model.Config
+	conf.TLS.Enabled = true
+	conf.TLS.CertFile = filepath.Join(dir, "cert.pem")
+	conf.TLS.CertKey = filepath.Join(dir, "key.pem")
+	conf.TLS.CAFile = filepath.Join(dir, "chain.pem")
+	files := map[string]*pem.Block{
+		conf.TLS.CertFile: {Type: "CERTIFICATE", Bytes: der},
+		conf.TLS.CertKey:  {Type: "EC PRIVATE KEY", Bytes: keyDer},
+		conf.TLS.CAFile:   {Type: "CERTIFICATE", Bytes: der},
+	}
+	for path, block := range files {
+		if err := ioutil.WriteFile(path, pem.EncodeToMemory(block), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &conf
+}
+
+func certCommonName(t *testing.T, r *certReloader) string {
+	c, err := r.getConfigForClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(c.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert.Subject.CommonName
+}
+
+func TestCertReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	conf := writeCertificate(t, dir, "old", now.Add(-time.Minute))
+	r, err := newCertReloader(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cn := certCommonName(t, r); cn != "old" {
+		t.Fatalf("expected old certificate, but received %s", cn)
+	}
+
+	// renewed certificate is used by new connections
+	writeCertificate(t, dir, "renewed", now)
+	if cn := certCommonName(t, r); cn != "renewed" {
+		t.Fatalf("expected renewed certificate, but received %s", cn)
+	}
+
+	// broken files do not replace loaded certificate
+	if err := ioutil.WriteFile(conf.TLS.CertFile, []byte("broken"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	os.Chtimes(conf.TLS.CertFile, now.Add(time.Minute), now.Add(time.Minute))
+	if cn := certCommonName(t, r); cn != "renewed" {
+		t.Fatalf("expected renewed certificate to be kept, but received %s", cn)
+	}
+	if err := r.reload(conf); err == nil {
+		t.Fatalf("expected error on reloading broken certificate, but received none")
+	}
+}
+
+func TestReload(t *testing.T) {
+	var conf model.Config
+	conf.SigninKey = "signkey"
+	conf.AuthKey = "authkey"
+
+	auth, err := newAuthenticator(&conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := server{auth: &reloadableAuth{auth: auth}, limit: newRateLimiter(&conf)}
+
+	oldToken := tokenContext(t, jwt.SigningMethodHS256, []byte("signkey"), "", jwt.MapClaims{AUTH_KEY: "authkey"})
+	newToken := tokenContext(t, jwt.SigningMethodHS256, []byte("new-signkey"), "", jwt.MapClaims{AUTH_KEY: "new-authkey"})
+	if _, err := s.auth.AuthenticateContext(oldToken); err != nil {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+
+	release, _, err := s.limit.acquire("jwt:daemon", "/store.Store/GetEvents")
+	if err != nil {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+
+	conf.SigninKey = "new-signkey"
+	conf.AuthKey = "new-authkey"
+	conf.RateLimit.MaxInFlight = 1
+	if err := s.Reload(&conf); err != nil {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+
+	if _, err := s.auth.AuthenticateContext(oldToken); err == nil {
+		t.Fatalf("expected token signed by old key to be rejected")
+	}
+	if _, err := s.auth.AuthenticateContext(newToken); err != nil {
+		t.Fatalf("expected no error, but received: %s", err)
+	}
+
+	// calls in flight before reload count against new limits
+	ctx := NewIdentityContext(context.Background(), &Identity{Name: "daemon", Source: JWTAuthMode})
+	if _, err := s.limit.allow(ctx, "/store.Store/GetEvents"); err == nil {
+		t.Fatalf("expected in-flight limit error, but received none")
+	}
+	release()
+
+	conf.TLS.Enabled = true
+	if err := s.Reload(&conf); err == nil {
+		t.Fatalf("expected error on enabling TLS without restart")
+	}
+}
@@ -0,0 +1,140 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// RetentionCaller and RetentionMethod identify anonymizations by retention policy in audit log
+	RetentionCaller = "retention-policy"
+	RetentionMethod = "AnonymizeTeams"
+
+	defaultRetentionInterval = time.Hour
+	// retentionLock is held by the replica which applies the retention policy
+	retentionLock = "haaukins-store-retention"
+)
+
+// retention anonymizes email and name of teams in events which finished
+// longer than anonymize-after ago, solves are kept for statistics.
+// When several replicas share the database, only the one holding the lock acts.
+type retention struct {
+	store          database.Store
+	interval       time.Duration
+	anonymizeAfter time.Duration
+	leader         bool
+	now            func() time.Time
+}
+
+func newRetention(store database.Store, conf *model.Config) *retention {
+	r := &retention{
+		store:          store,
+		interval:       conf.Retention.Interval,
+		anonymizeAfter: conf.Retention.AnonymizeAfter,
+		now:            func() time.Time { return time.Now().UTC() },
+	}
+	if r.interval <= 0 {
+		r.interval = defaultRetentionInterval
+	}
+	return r
+}
+
+// tick anonymizes teams which are due when this replica is the leader
+func (r *retention) tick(ctx context.Context) {
+	leader, err := r.store.Leader(ctx, retentionLock)
+	if err != nil {
+		log.Printf("ERR: Error electing retention leader: %s", err.Error())
+	}
+	if leader != r.leader {
+		r.leader = leader
+		if leader {
+			log.Printf("Retention policy is applied by this server")
+		} else {
+			log.Printf("Retention policy is applied by another server")
+		}
+	}
+	if !leader {
+		return
+	}
+
+	now := r.now()
+	teams, err := r.store.AnonymizeTeams(now.Add(-r.anonymizeAfter), model.AuditEntry{
+		CreatedAt: now,
+		Method:    RetentionMethod,
+		Caller:    RetentionCaller,
+		Code:      codes.OK.String(),
+	})
+	if err != nil {
+		log.Printf("ERR: Error anonymizing teams of finished events: %s", err.Error())
+		return
+	}
+	if len(teams) > 0 {
+		log.Printf("Retention policy anonymized %d teams of events finished before %s", len(teams), now.Add(-r.anonymizeAfter).Format(database.TimeFormat))
+	}
+}
+
+// run applies retention policy periodically until context is cancelled
+func (r *retention) run(ctx context.Context) {
+	r.tick(ctx)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.tick(ctx)
+		}
+	}
+}
+
+// RunRetention anonymizes teams of finished events until context is cancelled
+func (s server) RunRetention(ctx context.Context) {
+	s.retention.run(ctx)
+}
+
+// EraseTeamData anonymizes teams with the email in every event, email is not logged
+// and it is redacted in the audit entries which record the erasure of each team
+func (s server) EraseTeamData(ctx context.Context, in *pb.EraseTeamDataRequest) (*pb.EraseTeamDataResponse, error) {
+	if strings.TrimSpace(in.Email) == "" {
+		err := errors.New("email is required")
+		log.Printf("ERR: Error Erase Team Data : %s", err.Error())
+		return &pb.EraseTeamDataResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	entry := model.AuditEntry{
+		CreatedAt: time.Now().UTC(),
+		Method:    "EraseTeamData",
+		Request:   sanitizeRequest(&pb.EraseTeamDataRequest{Email: redacted}),
+		Code:      codes.OK.String(),
+	}
+	if id, ok := IdentityFromContext(ctx); ok {
+		entry.Caller = id.String()
+	}
+	teams, err := s.store.EraseTeamData(strings.TrimSpace(in.Email), entry)
+	if err != nil {
+		log.Printf("ERR: Error Erase Team Data : %s", err.Error())
+		return &pb.EraseTeamDataResponse{ErrorMessage: err.Error()}, nil
+	}
+	// requests which match no team are recorded as well
+	if len(teams) == 0 {
+		if err := s.store.AddAuditEntry(entry); err != nil {
+			log.Printf("ERR: Error writing audit log for %s: %s", entry.Method, err.Error())
+		}
+	}
+
+	resp := &pb.EraseTeamDataResponse{}
+	for _, t := range teams {
+		resp.Teams = append(resp.Teams, &pb.EraseTeamDataResponse_Team{EventTag: t.EventTag, Id: t.Id})
+	}
+	log.Printf("Team data erased from %d teams", len(teams))
+	return resp, nil
+}
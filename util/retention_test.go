@@ -0,0 +1,107 @@
+package util
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+type retentionStore struct {
+	database.Store
+	leader  bool
+	teams   []model.ErasedTeam
+	emails  []string
+	before  []time.Time
+	entries []model.AuditEntry
+}
+
+func (s *retentionStore) Leader(ctx context.Context, name string) (bool, error) {
+	return s.leader, nil
+}
+
+func (s *retentionStore) EraseTeamData(email string, audit model.AuditEntry) ([]model.ErasedTeam, error) {
+	s.emails = append(s.emails, email)
+	s.entries = append(s.entries, audit)
+	if email != "alice@example.com" {
+		return nil, nil
+	}
+	return s.teams, nil
+}
+
+func (s *retentionStore) AnonymizeTeams(finishedBefore time.Time, audit model.AuditEntry) ([]model.ErasedTeam, error) {
+	s.before = append(s.before, finishedBefore)
+	s.entries = append(s.entries, audit)
+	return s.teams, nil
+}
+
+func (s *retentionStore) AddAuditEntry(e model.AuditEntry) error {
+	s.entries = append(s.entries, e)
+	return nil
+}
+
+func TestRetention(t *testing.T) {
+	var conf model.Config
+	conf.Retention.AnonymizeAfter = 365 * 24 * time.Hour
+
+	now := time.Date(2021, 6, 4, 12, 0, 0, 0, time.UTC)
+	store := &retentionStore{teams: []model.ErasedTeam{{EventTag: "old", Id: "t1"}}}
+	r := newRetention(store, &conf)
+	r.now = func() time.Time { return now }
+
+	// replicas which do not hold the lock do nothing
+	r.tick(context.Background())
+	if len(store.before) != 0 {
+		t.Fatalf("expected no anonymization without leadership")
+	}
+
+	store.leader = true
+	r.tick(context.Background())
+	if len(store.before) != 1 || !store.before[0].Equal(now.Add(-conf.Retention.AnonymizeAfter)) {
+		t.Fatalf("expected teams of events finished before %s to be anonymized, but received %v", now.Add(-conf.Retention.AnonymizeAfter), store.before)
+	}
+	if e := store.entries[0]; e.Method != RetentionMethod || e.Caller != RetentionCaller || !e.CreatedAt.Equal(now) {
+		t.Errorf("unexpected audit entry %+v", e)
+	}
+	if r.interval != defaultRetentionInterval {
+		t.Errorf("expected default interval %s, but received %s", defaultRetentionInterval, r.interval)
+	}
+}
+
+func TestEraseTeamData(t *testing.T) {
+	store := &retentionStore{teams: []model.ErasedTeam{{EventTag: "test", Id: "t1"}, {EventTag: "old", Id: "t2"}}}
+	s := server{store: store}
+	id := &Identity{Name: "admin", Source: JWTAuthMode, Scopes: []string{AdminScope}}
+	ctx := NewIdentityContext(context.Background(), id)
+
+	resp, err := s.EraseTeamData(ctx, &pb.EraseTeamDataRequest{Email: " alice@example.com "})
+	if err != nil || resp.ErrorMessage != "" {
+		t.Fatalf("EraseTeamData() error = %v, %s", err, resp.ErrorMessage)
+	}
+	if len(resp.Teams) != 2 || resp.Teams[1].EventTag != "old" || resp.Teams[1].Id != "t2" {
+		t.Errorf("unexpected erased teams %+v", resp.Teams)
+	}
+	if store.emails[0] != "alice@example.com" {
+		t.Errorf("expected email to be trimmed, got %q", store.emails[0])
+	}
+	e := store.entries[0]
+	if e.Method != "EraseTeamData" || e.Caller != id.String() || strings.Contains(e.Request, "alice") {
+		t.Errorf("unexpected audit entry %+v", e)
+	}
+
+	// erasures which match no team are recorded too
+	if resp, _ := s.EraseTeamData(ctx, &pb.EraseTeamDataRequest{Email: "bob@example.com"}); len(resp.Teams) != 0 {
+		t.Errorf("expected no erased teams, got %+v", resp.Teams)
+	}
+	if len(store.entries) != 3 || strings.Contains(store.entries[2].Request, "bob") {
+		t.Errorf("expected erasure without teams to be recorded, got %+v", store.entries)
+	}
+
+	if resp, _ := s.EraseTeamData(ctx, &pb.EraseTeamDataRequest{Email: " "}); resp.ErrorMessage == "" {
+		t.Errorf("expected error on empty email")
+	}
+}
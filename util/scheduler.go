@@ -0,0 +1,107 @@
+package util
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+)
+
+const (
+	MarkExpired  = "mark"
+	CloseExpired = "close"
+
+	defaultSchedulerInterval = time.Minute
+	// schedulerLock is held by the replica which runs the scheduler
+	schedulerLock = "haaukins-store-scheduler"
+)
+
+// scheduler starts booked events at their start time and marks or closes
+// events which passed their expected finish time, changes are recorded
+// into status history. When several replicas share the database,
+// only the one holding the scheduler lock acts.
+type scheduler struct {
+	store        database.Store
+	interval     time.Duration
+	closeExpired bool
+	grace        time.Duration
+	leader       bool
+	now          func() time.Time
+}
+
+func newScheduler(store database.Store, conf *model.Config) *scheduler {
+	sc := &scheduler{
+		store:        store,
+		interval:     conf.Scheduler.Interval,
+		closeExpired: conf.Scheduler.Expired == CloseExpired,
+		grace:        conf.Scheduler.GracePeriod,
+		now:          func() time.Time { return time.Now().UTC() },
+	}
+	if sc.interval <= 0 {
+		sc.interval = defaultSchedulerInterval
+	}
+	return sc
+}
+
+// tick applies transitions which are due when this replica is the leader
+func (sc *scheduler) tick(ctx context.Context) {
+	leader, err := sc.store.Leader(ctx, schedulerLock)
+	if err != nil {
+		log.Printf("ERR: Error electing scheduler leader: %s", err.Error())
+	}
+	if leader != sc.leader {
+		sc.leader = leader
+		if leader {
+			log.Printf("Scheduler is leading, event transitions are applied by this server")
+		} else {
+			log.Printf("Scheduler is not leading, event transitions are applied by another server")
+		}
+	}
+	if !leader {
+		return
+	}
+
+	now := sc.now()
+	started, err := sc.store.StartBookedEvents(now)
+	if err != nil {
+		log.Printf("ERR: Error starting booked events: %s", err.Error())
+	}
+	for _, tag := range started {
+		log.Printf("Booked event %s is set as running", tag)
+	}
+
+	expired, err := sc.store.ExpireEvents(now, now.Add(-sc.grace), sc.closeExpired)
+	if err != nil {
+		log.Printf("ERR: Error expiring events: %s", err.Error())
+	}
+	for _, tag := range expired {
+		if sc.closeExpired {
+			log.Printf("Event %s passed its expected finish time and is closed", tag)
+		} else {
+			log.Printf("Event %s passed its expected finish time and is due for close", tag)
+		}
+	}
+}
+
+// run applies transitions periodically until context is cancelled
+func (sc *scheduler) run(ctx context.Context) {
+	sc.tick(ctx)
+	ticker := time.NewTicker(sc.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sc.tick(ctx)
+		}
+	}
+}
+
+// RunScheduler starts and expires events according to their times
+// until context is cancelled
+func (s server) RunScheduler(ctx context.Context) {
+	s.scheduler.run(ctx)
+}
@@ -0,0 +1,63 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+)
+
+type schedulerStore struct {
+	database.Store
+	leader  bool
+	started []time.Time
+	expired []time.Time
+	closed  []bool
+}
+
+func (s *schedulerStore) Leader(ctx context.Context, name string) (bool, error) {
+	return s.leader, nil
+}
+
+func (s *schedulerStore) StartBookedEvents(now time.Time) ([]string, error) {
+	s.started = append(s.started, now)
+	return []string{"booked"}, nil
+}
+
+func (s *schedulerStore) ExpireEvents(now, before time.Time, close bool) ([]string, error) {
+	s.expired = append(s.expired, before)
+	s.closed = append(s.closed, close)
+	return []string{"expired"}, nil
+}
+
+func TestScheduler(t *testing.T) {
+	var conf model.Config
+	conf.Scheduler.Enabled = true
+	conf.Scheduler.Expired = CloseExpired
+	conf.Scheduler.GracePeriod = time.Hour
+
+	now := time.Date(2021, 6, 4, 12, 0, 0, 0, time.UTC)
+	store := &schedulerStore{}
+	sc := newScheduler(store, &conf)
+	sc.now = func() time.Time { return now }
+
+	// replicas which do not hold the lock do nothing
+	sc.tick(context.Background())
+	if len(store.started) != 0 || len(store.expired) != 0 {
+		t.Fatalf("expected no transitions without leadership")
+	}
+
+	store.leader = true
+	sc.tick(context.Background())
+	if len(store.started) != 1 || !store.started[0].Equal(now) {
+		t.Fatalf("expected booked events to be started at %s, but received %v", now, store.started)
+	}
+	if len(store.expired) != 1 || !store.expired[0].Equal(now.Add(-time.Hour)) || !store.closed[0] {
+		t.Fatalf("expected events expected to finish before %s to be closed, but received %v %v", now.Add(-time.Hour), store.expired, store.closed)
+	}
+	if sc.interval != defaultSchedulerInterval {
+		t.Errorf("expected default interval %s, but received %s", defaultSchedulerInterval, sc.interval)
+	}
+}
@@ -0,0 +1,40 @@
+package util
+
+import (
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+const defaultDrainTimeout = 30 * time.Second
+
+// SetNotServing flips health status of the server to NOT_SERVING,
+// so health checks stop routing new calls before the server is stopped
+func (s server) SetNotServing() {
+	s.health.server.Shutdown()
+}
+
+// Drain stops accepting new calls and waits for in-flight calls to finish,
+// calls which are still running after timeout are cancelled
+func Drain(gRPCServer *grpc.Server, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		gRPCServer.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		log.Printf("All calls are drained")
+	case <-time.After(timeout):
+		log.Printf("Calls are not drained in %s, stopping server", timeout)
+		gRPCServer.Stop()
+	}
+}
+
+// Close closes database connections of the store,
+// it should be called after background workers are stopped
+func (s server) Close() error {
+	return s.store.Close()
+}
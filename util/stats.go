@@ -0,0 +1,161 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+const (
+	// solve times are measured either from creation of the team or start of the event
+	TeamSolveTime  = "team"
+	EventSolveTime = "event"
+)
+
+// challengeStats collects solves of a challenge
+type challengeStats struct {
+	solves int
+	times  []time.Duration
+}
+
+// EventStats computes statistics of the most recent event with given tag from its teams and their solves
+func EventStats(store database.Store, in *pb.GetEventStatsRequest) (*pb.GetEventStatsResponse, error) {
+	var activeSince time.Time
+	if in.ActiveSince != "" {
+		var err error
+		if activeSince, err = time.Parse(database.TimeFormat, in.ActiveSince); err != nil {
+			return nil, fmt.Errorf("invalid active since: %v", err)
+		}
+	}
+	if in.SolveTimeFrom != "" && in.SolveTimeFrom != TeamSolveTime && in.SolveTimeFrom != EventSolveTime {
+		return nil, fmt.Errorf("invalid solve time from %q, use %s or %s", in.SolveTimeFrom, TeamSolveTime, EventSolveTime)
+	}
+
+	a, err := store.ExportEvent(in.EventTag)
+	if err != nil {
+		return nil, err
+	}
+	return eventStats(a, activeSince, in.SolveTimeFrom == EventSolveTime)
+}
+
+func eventStats(a model.EventArchive, activeSince time.Time, fromEventStart bool) (*pb.GetEventStatsResponse, error) {
+	eventStart, err := database.ParseArchiveTime(a.Event.StartedAt)
+	if err != nil {
+		return nil, err
+	}
+	if fromEventStart && eventStart.IsZero() {
+		return nil, fmt.Errorf("event %s has no start time", a.Event.Tag)
+	}
+
+	challenges := map[string]*challengeStats{}
+	for _, tag := range exerciseTags(a.Event.Exercises) {
+		challenges[tag] = &challengeStats{}
+	}
+
+	resp := &pb.GetEventStatsResponse{Teams: int32(len(a.Teams))}
+	var times []time.Duration
+	var zeroSolves int
+	for _, t := range a.Teams {
+		createdAt, err := database.ParseArchiveTime(t.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("team %s: %v", t.Id, err)
+		}
+		lastAccess, err := database.ParseArchiveTime(t.LastAccess)
+		if err != nil {
+			return nil, fmt.Errorf("team %s: %v", t.Id, err)
+		}
+		if activeSince.IsZero() && lastAccess.After(createdAt) || !activeSince.IsZero() && !lastAccess.Before(activeSince) {
+			resp.ActiveTeams++
+		}
+		if len(t.Solves) == 0 {
+			zeroSolves++
+		}
+
+		start := createdAt
+		if fromEventStart {
+			start = eventStart
+		}
+		for _, s := range t.Solves {
+			c, ok := challenges[s.Challenge]
+			if !ok {
+				c = &challengeStats{}
+				challenges[s.Challenge] = c
+			}
+			c.solves++
+			resp.Solves++
+
+			// solves without valid time are counted, however they are left out of solve times
+			completedAt, err := database.ParseArchiveTime(s.CompletedAt)
+			if err != nil || completedAt.IsZero() || completedAt.Before(start) {
+				continue
+			}
+			c.times = append(c.times, completedAt.Sub(start))
+			times = append(times, completedAt.Sub(start))
+		}
+	}
+
+	if len(a.Teams) > 0 {
+		resp.ZeroSolveRatio = float64(zeroSolves) / float64(len(a.Teams))
+	}
+	resp.MedianSolveSeconds = int64(median(times).Seconds())
+	for tag, c := range challenges {
+		ch := &pb.GetEventStatsResponse_Challenge{
+			Tag:                tag,
+			Solves:             int32(c.solves),
+			MedianSolveSeconds: int64(median(c.times).Seconds()),
+		}
+		if len(a.Teams) > 0 {
+			ch.SolvedRatio = float64(c.solves) / float64(len(a.Teams))
+		}
+		resp.Challenges = append(resp.Challenges, ch)
+	}
+	sort.Slice(resp.Challenges, func(i, j int) bool {
+		ci, cj := resp.Challenges[i], resp.Challenges[j]
+		if ci.Solves != cj.Solves {
+			return ci.Solves > cj.Solves
+		}
+		return ci.Tag < cj.Tag
+	})
+	return resp, nil
+}
+
+// exerciseTags splits comma separated exercises of an event
+func exerciseTags(exercises string) []string {
+	var tags []string
+	for _, tag := range strings.Split(exercises, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// median returns the middle duration, or average of the two middle ones, zero when there is none
+func median(d []time.Duration) time.Duration {
+	if len(d) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), d...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+func (s server) GetEventStats(ctx context.Context, in *pb.GetEventStatsRequest) (*pb.GetEventStatsResponse, error) {
+	resp, err := EventStats(s.store, in)
+	if err != nil {
+		log.Printf("ERR: Error Get Event Stats %s : %s", in.EventTag, err.Error())
+		return &pb.GetEventStatsResponse{ErrorMessage: err.Error()}, nil
+	}
+	return resp, nil
+}
@@ -0,0 +1,90 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+type statsStore struct {
+	database.Store
+	archive model.EventArchive
+}
+
+func (s *statsStore) ExportEvent(tag string) (model.EventArchive, error) {
+	return s.archive, nil
+}
+
+func TestGetEventStats(t *testing.T) {
+	store := &statsStore{archive: model.EventArchive{
+		Event: model.ArchivedEvent{Tag: "test", Exercises: "ftp,xss,sql", StartedAt: "2021-06-04T10:00:00Z"},
+		Teams: []model.ArchivedTeam{
+			{Id: "t1", CreatedAt: "2021-06-04T11:00:00Z", LastAccess: "2021-06-04T15:00:00Z", Solves: []model.ArchivedSolve{
+				{Challenge: "ftp", CompletedAt: "2021-06-04 11:10:00"},
+				{Challenge: "xss", CompletedAt: "2021-06-04 12:00:00"},
+			}},
+			{Id: "t2", CreatedAt: "2021-06-04T10:00:00Z", LastAccess: "2021-06-04T12:00:00Z", Solves: []model.ArchivedSolve{
+				{Challenge: "ftp", CompletedAt: "2021-06-04 10:30:00"},
+				{Challenge: "hidden", CompletedAt: ""},
+			}},
+			{Id: "t3", CreatedAt: "2021-06-04T10:00:00Z", LastAccess: "2021-06-04T10:00:00Z", Solves: []model.ArchivedSolve{}},
+		},
+	}}
+	s := server{store: store}
+
+	resp, err := s.GetEventStats(context.Background(), &pb.GetEventStatsRequest{EventTag: "test"})
+	if err != nil || resp.ErrorMessage != "" {
+		t.Fatalf("GetEventStats() error = %v, %s", err, resp.ErrorMessage)
+	}
+	if resp.Teams != 3 || resp.ActiveTeams != 2 || resp.Solves != 4 {
+		t.Errorf("unexpected counts %+v", resp)
+	}
+	if resp.ZeroSolveRatio != 1.0/3 {
+		t.Errorf("expected zero solve ratio 1/3, got %f", resp.ZeroSolveRatio)
+	}
+	// team solve times are 10m, 1h and 30m
+	if resp.MedianSolveSeconds != 30*60 {
+		t.Errorf("expected median solve time 30m, got %ds", resp.MedianSolveSeconds)
+	}
+
+	want := []struct {
+		tag    string
+		solves int32
+		median int64
+	}{
+		{"ftp", 2, 20 * 60},
+		{"hidden", 1, 0},
+		{"xss", 1, 60 * 60},
+		{"sql", 0, 0},
+	}
+	if len(resp.Challenges) != len(want) {
+		t.Fatalf("expected %d challenges, got %+v", len(want), resp.Challenges)
+	}
+	for i, w := range want {
+		c := resp.Challenges[i]
+		if c.Tag != w.tag || c.Solves != w.solves || c.MedianSolveSeconds != w.median {
+			t.Errorf("expected challenge %s with %d solves in %ds, got %+v", w.tag, w.solves, w.median, c)
+		}
+	}
+
+	resp, _ = s.GetEventStats(context.Background(), &pb.GetEventStatsRequest{EventTag: "test", ActiveSince: "2021-06-04 13:00:00", SolveTimeFrom: EventSolveTime})
+	if resp.ActiveTeams != 1 {
+		t.Errorf("expected 1 team active since 13:00, got %d", resp.ActiveTeams)
+	}
+	// event solve times are 1h10m, 2h and 30m
+	if resp.MedianSolveSeconds != 70*60 {
+		t.Errorf("expected median solve time from event start 1h10m, got %ds", resp.MedianSolveSeconds)
+	}
+
+	for _, in := range []*pb.GetEventStatsRequest{
+		{EventTag: "test", ActiveSince: "yesterday"},
+		{EventTag: "test", SolveTimeFrom: "challenge"},
+	} {
+		if resp, _ := s.GetEventStats(context.Background(), in); resp.ErrorMessage == "" {
+			t.Errorf("expected error on request %+v", in)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aau-network-security/haaukins-store/database"
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+const (
+	defaultTimelineInterval = time.Hour
+	defaultTopTeams         = 10
+
+	// maxTimelineBuckets limits the size of a timeline, e.g. one minute intervals of a week long event
+	maxTimelineBuckets = 2000
+)
+
+// timedSolve is a solve which has a valid completion time
+type timedSolve struct {
+	team      int
+	challenge string
+	at        time.Time
+}
+
+// SolveTimeline counts solves of the most recent event with given tag in intervals
+// and returns cumulative points of the teams with most points
+func SolveTimeline(store database.Store, in *pb.GetSolveTimelineRequest) (*pb.GetSolveTimelineResponse, error) {
+	interval := defaultTimelineInterval
+	if in.Interval != "" {
+		var err error
+		if interval, err = time.ParseDuration(in.Interval); err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid interval %q", in.Interval)
+		}
+	}
+	top := int(in.TopTeams)
+	if top == 0 {
+		top = defaultTopTeams
+	}
+	if top < 0 {
+		return nil, fmt.Errorf("top teams cannot be negative")
+	}
+
+	a, err := store.ExportEvent(in.EventTag)
+	if err != nil {
+		return nil, err
+	}
+	return solveTimeline(a, interval, top, in.Points)
+}
+
+func solveTimeline(a model.EventArchive, interval time.Duration, top int, points map[string]int32) (*pb.GetSolveTimelineResponse, error) {
+	eventStart, err := database.ParseArchiveTime(a.Event.StartedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// solves without valid completion time could not be placed on the timeline
+	var solves []timedSolve
+	for i, t := range a.Teams {
+		for _, s := range t.Solves {
+			at, err := database.ParseArchiveTime(s.CompletedAt)
+			if err != nil || at.IsZero() {
+				continue
+			}
+			solves = append(solves, timedSolve{team: i, challenge: s.Challenge, at: at})
+		}
+	}
+	resp := &pb.GetSolveTimelineResponse{}
+	if len(solves) == 0 {
+		return resp, nil
+	}
+	sort.Slice(solves, func(i, j int) bool { return solves[i].at.Before(solves[j].at) })
+
+	start := solves[0].at
+	if !eventStart.IsZero() && eventStart.Before(start) {
+		start = eventStart
+	}
+	start = start.Truncate(interval)
+	// span is compared before conversion, since it saturates when solves are centuries apart
+	span := solves[len(solves)-1].at.Sub(start)
+	if span/interval >= maxTimelineBuckets {
+		return nil, fmt.Errorf("interval %s is too short for solves between %s and %s, limit is %d intervals",
+			interval, start.Format(database.TimeFormat), solves[len(solves)-1].at.Format(database.TimeFormat), maxTimelineBuckets)
+	}
+	n := int(span/interval) + 1
+
+	for i := 0; i < n; i++ {
+		resp.Buckets = append(resp.Buckets, &pb.GetSolveTimelineResponse_Bucket{
+			Time:   start.Add(time.Duration(i) * interval).Format(database.TimeFormat),
+			Solves: map[string]int32{},
+		})
+	}
+	teams := make([]*pb.GetSolveTimelineResponse_Team, len(a.Teams))
+	lastSolve := make([]time.Time, len(a.Teams))
+	for i, t := range a.Teams {
+		teams[i] = &pb.GetSolveTimelineResponse_Team{Id: t.Id, Name: t.Name, Cumulative: make([]int32, n)}
+	}
+	for _, s := range solves {
+		b := int(s.at.Sub(start) / interval)
+		resp.Buckets[b].Solves[s.challenge]++
+		resp.Buckets[b].Total++
+
+		p, ok := points[s.challenge]
+		if !ok {
+			p = 1
+		}
+		teams[s.team].Points += p
+		teams[s.team].Cumulative[b] += p
+		lastSolve[s.team] = s.at
+	}
+	for _, t := range teams {
+		for b := 1; b < n; b++ {
+			t.Cumulative[b] += t.Cumulative[b-1]
+		}
+	}
+
+	// teams which reached the same points earlier are ranked higher
+	order := make([]int, len(teams))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		ti, tj := teams[order[i]], teams[order[j]]
+		if ti.Points != tj.Points {
+			return ti.Points > tj.Points
+		}
+		li, lj := lastSolve[order[i]], lastSolve[order[j]]
+		if li.IsZero() != lj.IsZero() {
+			return lj.IsZero()
+		}
+		return li.Before(lj)
+	})
+	for i := 0; i < len(order) && i < top; i++ {
+		resp.Teams = append(resp.Teams, teams[order[i]])
+	}
+	return resp, nil
+}
+
+func (s server) GetSolveTimeline(ctx context.Context, in *pb.GetSolveTimelineRequest) (*pb.GetSolveTimelineResponse, error) {
+	resp, err := SolveTimeline(s.store, in)
+	if err != nil {
+		log.Printf("ERR: Error Get Solve Timeline %s : %s", in.EventTag, err.Error())
+		return &pb.GetSolveTimelineResponse{ErrorMessage: err.Error()}, nil
+	}
+	return resp, nil
+}
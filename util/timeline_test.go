@@ -0,0 +1,102 @@
+package util
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aau-network-security/haaukins-store/model"
+	pb "github.com/aau-network-security/haaukins-store/proto"
+)
+
+func TestGetSolveTimeline(t *testing.T) {
+	store := &statsStore{archive: model.EventArchive{
+		Event: model.ArchivedEvent{Tag: "test", StartedAt: "2021-06-04T10:15:00Z"},
+		Teams: []model.ArchivedTeam{
+			{Id: "t1", Name: "Team 1", Solves: []model.ArchivedSolve{
+				{Challenge: "ftp", CompletedAt: "2021-06-04 10:20:00"},
+				{Challenge: "xss", CompletedAt: "2021-06-04 12:30:00"},
+			}},
+			{Id: "t2", Name: "Team 2", Solves: []model.ArchivedSolve{
+				{Challenge: "ftp", CompletedAt: "2021-06-04 11:05:00"},
+				{Challenge: "sql", CompletedAt: "2021-06-04 11:10:00"},
+				{Challenge: "hidden", CompletedAt: ""},
+			}},
+			{Id: "t3", Name: "Team 3", Solves: []model.ArchivedSolve{
+				{Challenge: "sql", CompletedAt: "2021-06-04 10:50:00"},
+			}},
+			{Id: "t4", Name: "Team 4", Solves: []model.ArchivedSolve{}},
+		},
+	}}
+	s := server{store: store}
+
+	resp, err := s.GetSolveTimeline(context.Background(), &pb.GetSolveTimelineRequest{
+		EventTag: "test",
+		TopTeams: 3,
+		Points:   map[string]int32{"sql": 3},
+	})
+	if err != nil || resp.ErrorMessage != "" {
+		t.Fatalf("GetSolveTimeline() error = %v, %s", err, resp.ErrorMessage)
+	}
+
+	wantBuckets := []struct {
+		time   string
+		solves map[string]int32
+	}{
+		{"2021-06-04 10:00:00", map[string]int32{"ftp": 1, "sql": 1}},
+		{"2021-06-04 11:00:00", map[string]int32{"ftp": 1, "sql": 1}},
+		{"2021-06-04 12:00:00", map[string]int32{"xss": 1}},
+	}
+	if len(resp.Buckets) != len(wantBuckets) {
+		t.Fatalf("expected %d buckets, got %+v", len(wantBuckets), resp.Buckets)
+	}
+	for i, w := range wantBuckets {
+		b := resp.Buckets[i]
+		if b.Time != w.time || !reflect.DeepEqual(b.Solves, w.solves) || int(b.Total) != len(w.solves) {
+			t.Errorf("expected bucket %s with %v, got %+v", w.time, w.solves, b)
+		}
+	}
+
+	// Team 2 and Team 3 both have 3 points after 10:50, Team 3 reached them earlier
+	wantTeams := []struct {
+		id         string
+		points     int32
+		cumulative []int32
+	}{
+		{"t2", 4, []int32{0, 4, 4}},
+		{"t3", 3, []int32{3, 3, 3}},
+		{"t1", 2, []int32{1, 1, 2}},
+	}
+	if len(resp.Teams) != len(wantTeams) {
+		t.Fatalf("expected %d teams, got %+v", len(wantTeams), resp.Teams)
+	}
+	for i, w := range wantTeams {
+		team := resp.Teams[i]
+		if team.Id != w.id || team.Points != w.points || !reflect.DeepEqual(team.Cumulative, w.cumulative) {
+			t.Errorf("expected team %s with %d points %v, got %+v", w.id, w.points, w.cumulative, team)
+		}
+	}
+
+	resp, _ = s.GetSolveTimeline(context.Background(), &pb.GetSolveTimelineRequest{EventTag: "test", Interval: "30m"})
+	if len(resp.Buckets) != 6 || resp.Buckets[0].Time != "2021-06-04 10:00:00" || len(resp.Teams) != 4 {
+		t.Errorf("unexpected timeline with 30m interval %+v", resp)
+	}
+
+	for _, in := range []*pb.GetSolveTimelineRequest{
+		{EventTag: "test", Interval: "hourly"},
+		{EventTag: "test", Interval: "-1h"},
+		{EventTag: "test", Interval: "1s"},
+		{EventTag: "test", TopTeams: -1},
+	} {
+		if resp, _ := s.GetSolveTimeline(context.Background(), in); resp.ErrorMessage == "" {
+			t.Errorf("expected error on request %+v", in)
+		}
+	}
+
+	// solves centuries apart saturate their duration, which should not overflow number of intervals
+	store.archive.Teams[3].Solves = []model.ArchivedSolve{{Challenge: "ftp", CompletedAt: "1700-01-01 00:00:00"}}
+	resp, err = s.GetSolveTimeline(context.Background(), &pb.GetSolveTimelineRequest{EventTag: "test", Interval: "1ns"})
+	if err != nil || resp.ErrorMessage == "" {
+		t.Errorf("expected error on timeline with far past solve, got %v %+v", err, resp)
+	}
+}
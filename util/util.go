@@ -2,25 +2,32 @@ package util
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"log"
 
 	"github.com/aau-network-security/haaukins-store/database"
 	"github.com/aau-network-security/haaukins-store/model"
 	pb "github.com/aau-network-security/haaukins-store/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
-	"gopkg.in/yaml.v2"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
 type server struct {
-	store database.Store
-	auth  Authenticator
-	tls   bool
+	store      database.Store
+	auth       Authenticator
+	audit      *auditor // nil when audit log is disabled
+	limit      *rateLimiter
+	health     *healthChecker
+	metrics    *metrics
+	capacity   *capacityBudget
+	scheduler  *scheduler         // nil when scheduler is disabled
+	inactivity *inactivityMonitor // nil when inactive teams policy is disabled
+	retention  *retention         // nil when retention policy is disabled
+	certs      *certReloader      // nil when TLS is disabled
 	pb.UnimplementedStoreServer
 }
 
@@ -41,7 +48,7 @@ var (
 type State int32
 
 func (s server) AddEvent(ctx context.Context, in *pb.AddEventRequest) (*pb.InsertResponse, error) {
-	result, err := s.store.AddEvent(in)
+	result, err := s.addEvent(in)
 	if err != nil {
 		log.Printf("ERR: Error Add Event %s", err.Error())
 		return &pb.InsertResponse{ErrorMessage: err.Error()}, nil
@@ -116,6 +123,26 @@ func (s server) GetEventStatus(ctx context.Context, in *pb.GetEventStatusRequest
 
 }
 
+func (s server) GetEventStatusHistory(ctx context.Context, in *pb.GetEventStatusRequest) (*pb.GetEventStatusHistoryResponse, error) {
+	result, err := s.store.GetStatusHistory(in.EventTag)
+	if err != nil {
+		log.Printf("ERR: Error Get Status History for Event %s : %s", in.EventTag, err.Error())
+		return &pb.GetEventStatusHistoryResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	var changes []*pb.GetEventStatusHistoryResponse_Change
+	for _, c := range result {
+		changes = append(changes, &pb.GetEventStatusHistoryResponse_Change{
+			Status:         c.Status,
+			PreviousStatus: c.PreviousStatus,
+			ChangedAt:      c.ChangedAt.Format(database.TimeFormat),
+			Source:         c.Source,
+			Reason:         c.Reason,
+		})
+	}
+	return &pb.GetEventStatusHistoryResponse{Changes: changes}, nil
+}
+
 func (s server) SetEventStatus(ctx context.Context, in *pb.SetEventStatusRequest) (*pb.EventStatusStore, error) {
 	log.Printf("Set event status for event %s to %d", in.EventTag, in.Status)
 	result, err := s.store.SetEventStatus(in)
@@ -137,6 +164,24 @@ func (s server) GetTimeSeries(ctx context.Context, r *pb.EmptyRequest) (*pb.GetT
 	return &pb.GetTimeSeriesResponse{Timeseries: m}, nil
 }
 
+func (s server) GetUsageTimeSeries(ctx context.Context, in *pb.GetUsageTimeSeriesRequest) (*pb.GetUsageTimeSeriesResponse, error) {
+	result, err := s.store.GetUsageTimeSeries(in)
+	if err != nil {
+		log.Printf("ERR: Error Get Usage Time Series %s", err.Error())
+		return &pb.GetUsageTimeSeriesResponse{ErrorMessage: err.Error()}, nil
+	}
+
+	var points []*pb.GetUsageTimeSeriesResponse_Point
+	for _, p := range result {
+		points = append(points, &pb.GetUsageTimeSeriesResponse_Point{
+			Time:  p.Time.Format(database.TimeFormat),
+			Group: p.Group,
+			Vms:   p.VMs,
+		})
+	}
+	return &pb.GetUsageTimeSeriesResponse{Points: points}, nil
+}
+
 func (s server) GetEventTeams(ctx context.Context, in *pb.GetEventTeamsRequest) (*pb.GetEventTeamsResponse, error) {
 	result, err := s.store.GetTeams(in.EventTag)
 	if err != nil {
@@ -193,7 +238,8 @@ func (s server) DeleteTeam(ctx context.Context, req *pb.DelTeamRequest) (*pb.Del
 	result, err := s.store.DelTeam(req)
 	if err != nil {
 		log.Printf("ERR: Error delete team %s from event %s, err: %s", req.TeamId, req.EvTag, err.Error())
-		return &pb.DelTeamResp{Message: err.Error()}, nil
+		// message is kept for clients which do not read errorMessage
+		return &pb.DelTeamResp{Message: err.Error(), ErrorMessage: err.Error()}, nil
 	}
 	return &pb.DelTeamResp{Message: result}, nil
 }
@@ -225,52 +271,25 @@ func (s server) GetEventID(ctx context.Context, req *pb.GetEventIDReq) (*pb.GetE
 	return &pb.GetEventIDResp{EventID: id}, nil
 }
 
+// GetCreds returns TLS credentials of the server, certificates are loaded
+// again from configured files whenever they change on disk
 func GetCreds(conf *model.Config) (credentials.TransportCredentials, error) {
 	log.Printf("Preparing credentials for RPC")
 
-	certificateProps := certificate{
-		cPath:    conf.TLS.CertFile,
-		cKeyPath: conf.TLS.CertKey,
-		caPath:   conf.TLS.CAFile,
-	}
-
-	certificate, err := tls.LoadX509KeyPair(certificateProps.cPath, certificateProps.cKeyPath)
+	certs, err := newCertReloader(conf)
 	if err != nil {
-		return nil, fmt.Errorf("could not load server key pair: %s", err)
-	}
-
-	// Create a certificate pool from the certificate authority
-	certPool := x509.NewCertPool()
-	ca, err := ioutil.ReadFile(certificateProps.caPath)
-	if err != nil {
-		return nil, fmt.Errorf("could not read ca certificate: %s", err)
-	}
-	// CA file for let's encrypt is located under domain conf as `chain.pem`
-	// pass chain.pem location
-	// Append the client certificates from the CA
-	if ok := certPool.AppendCertsFromPEM(ca); !ok {
-		return nil, errors.New("failed to append client certs")
+		return nil, err
 	}
 
 	// Create the TLS credentials
-	creds := credentials.NewTLS(&tls.Config{
-		ClientAuth:   tls.RequireAndVerifyClientCert,
-		Certificates: []tls.Certificate{certificate},
-		ClientCAs:    certPool,
-	})
-	return creds, nil
+	return credentials.NewTLS(certs.tlsConfig()), nil
 }
 
 func (s server) GrpcOpts(conf *model.Config) ([]grpc.ServerOption, error) {
 
-	if conf.TLS.Enabled {
-		creds, err := GetCreds(conf)
-
-		if err != nil {
-			return []grpc.ServerOption{}, errors.New("Error on retrieving certificates: " + err.Error())
-		}
+	if s.certs != nil {
 		log.Printf("Server is running in secure mode !")
-		return []grpc.ServerOption{grpc.Creds(creds)}, nil
+		return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(s.certs.tlsConfig()))}, nil
 	}
 	return []grpc.ServerOption{}, nil
 }
@@ -278,24 +297,71 @@ func (s server) GrpcOpts(conf *model.Config) ([]grpc.ServerOption, error) {
 func (s server) GetGRPCServer(opts ...grpc.ServerOption) *grpc.Server {
 
 	streamInterceptor := func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		if err := s.auth.AuthenticateContext(stream.Context()); err != nil {
-			return err
+		if isHealthMethod(info.FullMethod) {
+			return handler(srv, stream)
+		}
+		id, err := s.auth.AuthenticateContext(stream.Context())
+		if err != nil {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		if !id.CanCall(info.FullMethod) {
+			return status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", id, info.FullMethod)
 		}
-		return handler(srv, stream)
+		return handler(srv, &identityStream{ServerStream: stream, ctx: NewIdentityContext(stream.Context(), id)})
 	}
 
 	unaryInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		if err := s.auth.AuthenticateContext(ctx); err != nil {
-			return nil, err
+		if isHealthMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		id, err := s.auth.AuthenticateContext(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if !id.CanCall(info.FullMethod) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", id, info.FullMethod)
 		}
-		return handler(ctx, req)
+		return handler(NewIdentityContext(ctx, id), req)
+	}
+
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if s.metrics != nil {
+		unaryInterceptors = append(unaryInterceptors, s.metrics.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, s.metrics.streamInterceptor)
+	}
+	unaryInterceptors = append(unaryInterceptors, unaryInterceptor)
+	streamInterceptors = append(streamInterceptors, streamInterceptor)
+	// limits could be enabled later by reloading configuration
+	unaryInterceptors = append(unaryInterceptors, s.limit.unaryInterceptor)
+	streamInterceptors = append(streamInterceptors, s.limit.streamInterceptor)
+	if s.audit != nil {
+		unaryInterceptors = append(unaryInterceptors, s.audit.unaryInterceptor)
 	}
 
 	opts = append([]grpc.ServerOption{
-		grpc.StreamInterceptor(streamInterceptor),
-		grpc.UnaryInterceptor(unaryInterceptor),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 	}, opts...)
-	return grpc.NewServer(opts...)
+	gRPCServer := grpc.NewServer(opts...)
+	healthpb.RegisterHealthServer(gRPCServer, s.health.server)
+	return gRPCServer
+}
+
+// MonitorHealth updates health status of the server
+// by checking database periodically until context is cancelled
+func (s server) MonitorHealth(ctx context.Context) {
+	s.health.run(ctx)
+}
+
+// identityStream carries the identity of the caller in the stream context
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *identityStream) Context() context.Context {
+	return s.ctx
 }
 
 func InitilizegRPCServer(conf *model.Config) (*server, error) {
@@ -306,56 +372,40 @@ func InitilizegRPCServer(conf *model.Config) (*server, error) {
 		return nil, err
 	}
 
-	s := &server{
-		store: store,
-		auth:  NewAuthenticator(conf.SigninKey, conf.AuthKey),
-		tls:   conf.TLS.Enabled,
-	}
-	return s, nil
-}
-
-func NewConfigFromFile(path string) (*model.Config, error) {
-	f, err := ioutil.ReadFile(path)
+	auth, err := newAuthenticator(conf)
 	if err != nil {
 		return nil, err
 	}
 
-	var c model.Config
-	err = yaml.Unmarshal(f, &c)
-	if err != nil {
-		return nil, err
+	s := &server{
+		store:    store,
+		auth:     &reloadableAuth{auth: auth},
+		limit:    newRateLimiter(conf),
+		health:   newHealthChecker(store),
+		capacity: newCapacityBudget(conf),
 	}
-
-	if c.Host == "" {
-		log.Println("Host not provided in the configuration file")
-		c.Host = "localhost:50051"
+	if conf.TLS.Enabled {
+		s.certs, err = newCertReloader(conf)
+		if err != nil {
+			return nil, errors.New("Error on retrieving certificates: " + err.Error())
+		}
 	}
-
-	if c.SigninKey == "" {
-		log.Println("SigninKey not provided in the configuration file")
-		c.Host = "dev-env"
+	if conf.Metrics.Host != "" {
+		s.metrics = newMetrics(store)
 	}
-
-	if c.AuthKey == "" {
-		log.Println("AuthKey not provided in the configuration file")
-		c.Host = "development-environment"
+	if conf.Scheduler.Enabled {
+		s.scheduler = newScheduler(store, conf)
 	}
-
-	if c.DB.Host == "" || c.DB.User == "" || c.DB.Pass == "" || c.DB.Name == "" {
-		return nil, errors.New("DB paramenters missing in the configuration file")
+	if conf.InactiveTeams.Enabled {
+		s.inactivity = newInactivityMonitor(store, conf)
 	}
-
-	if c.DB.Port == 0 {
-		c.DB.Port = 5432
+	if conf.Retention.AnonymizeAfter > 0 {
+		s.retention = newRetention(store, conf)
 	}
-
-	if c.TLS.Enabled {
-		if c.TLS.CAFile == "" || c.TLS.CertKey == "" || c.TLS.CertFile == "" {
-			return nil, errors.New("Provide Certificates in the config file")
-		}
+	if !conf.Audit.Disabled {
+		s.audit = newAuditor(store, conf)
 	}
-
-	return &c, nil
+	return s, nil
 }
 
 func getEventsResponse(result []model.Event) []*pb.GetEventResponse_Events {